@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/translator"
+)
+
+var attributeNamesKey contextKey = "attribute_names"
+
+// BindAttributeNames binds attribute display names to context.
+// They take precedence over both the translator and [WithAttributeNames] for the validation using the context.
+func BindAttributeNames(ctx context.Context, names map[string]string) context.Context {
+	return context.WithValue(ctx, attributeNamesKey, names)
+}
+
+// AttributeNamesFromContext returns attribute display names from context.
+func AttributeNamesFromContext(ctx context.Context) map[string]string {
+	names, _ := ctx.Value(attributeNamesKey).(map[string]string)
+	return names
+}
+
+// isDefaultLanguage reports whether the language is the default language of the validator,
+// which the names set by [WithAttributeNames] are for.
+func (v *Validator) isDefaultLanguage(language string) bool {
+	tag, defaultTag := translator.Parents(language), translator.Parents(v.defaultLanguage)
+	if len(tag) == 0 || len(defaultTag) == 0 {
+		return len(tag) == len(defaultTag)
+	}
+	return tag[0] == defaultTag[0]
+}
+
+// attributeTranslator resolves "attribute.<name>" keys from the overrides bound to the context first,
+// then from the wrapped translator, and at last from the names of the validator.
+type attributeTranslator struct {
+	validation.Translator
+	overrides map[string]string
+	names     map[string]string
+}
+
+func (t *attributeTranslator) T(key string, params map[string]any) string {
+	name, ok := strings.CutPrefix(key, "attribute.")
+	if !ok {
+		return t.Translator.T(key, params)
+	}
+	if displayName, ok := t.overrides[name]; ok {
+		return displayName
+	}
+	if displayName := t.Translator.T(key, params); displayName != "" {
+		return displayName
+	}
+	return t.names[name]
+}
+
+func (t *attributeTranslator) Locale(language string) validation.Translator {
+	return &attributeTranslator{
+		Translator: t.Translator.Locale(language),
+		overrides:  t.overrides,
+		names:      t.names,
+	}
+}
@@ -2,7 +2,6 @@ package validation
 
 import (
 	"context"
-	"strings"
 
 	"github.com/gopi-frame/contract/validation"
 	error2 "github.com/gopi-frame/validation/errpack"
@@ -16,6 +15,7 @@ type Builder struct {
 	validator validation.Validatable
 	attribute string
 	paths     []string
+	bail      bool
 }
 
 func NewBuilder(validator validation.Validatable) *Builder {
@@ -38,23 +38,63 @@ func (b *Builder) SetKey(paths ...string) validation.ValidatorBuilder {
 	return b
 }
 
+// SetPath sets the key as path segments, unlike SetKey whose paths are dotted,
+// so that the segments may contain dots, e.g. the keys of a map.
+func (b *Builder) SetPath(path error2.Path) *Builder {
+	b.paths = []string{path.String()}
+	return b
+}
+
 func (b *Builder) GetKey() []string {
 	return b.paths
 }
 
+// SetBail sets whether to stop at the first error.
+// A bailing builder stops its own rules at the first error,
+// and no more validators of the same key run after it fails.
+func (b *Builder) SetBail(bail bool) validation.ValidatorBuilder {
+	b.bail = bail
+	return b
+}
+
+func (b *Builder) GetBail() bool {
+	return b.bail
+}
+
 func (b *Builder) Build(ctx validation.ValidatorContext) {
-	paths := b.paths
-	if len(paths) == 0 {
-		paths = []string{b.attribute}
+	key := b.attribute
+	if len(b.paths) > 0 {
+		key = joinPaths(b.paths).String()
 	}
-	ctx.AddValidate(strings.Join(paths, "."), validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+	var v validation.Validatable = validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+		if b.bail {
+			ctx = validator.BindBail(ctx)
+		}
 		if err := b.validator.Validate(ctx, builder); err != nil {
-			if err.HasParam("attribute") {
+			if b.attribute == "" || err.HasParam("attribute") {
 				return err
 			}
 			err = err.AddParam(error2.NewParam("attribute", b.attribute))
 			return err
 		}
 		return nil
-	}))
+	})
+	if b.bail {
+		v = bailValidatable{v}
+	}
+	ctx.AddValidate(key, v)
+}
+
+// joinPaths joins the dotted paths, see [error2.ParsePath].
+func joinPaths(paths []string) error2.Path {
+	var path error2.Path
+	for _, p := range paths {
+		path = path.Join(error2.ParsePath(p))
+	}
+	return path
+}
+
+// bailValidatable marks a validatable after whose failure the remaining validators of the same key are skipped.
+type bailValidatable struct {
+	validation.Validatable
 }
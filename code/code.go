@@ -108,6 +108,7 @@ const (
 	IsURLWithScheme = "is_url_with_schema"
 	IsRequestURI    = "is_request_uri"
 	IsURLQuery      = "is_url_query"
+	IsEmail         = "is_email"
 )
 
 // enum validator codes
@@ -126,3 +127,32 @@ const (
 	IsPathAbsolute  = "is_path_absolute"
 	IsPathRelative  = "is_path_relative"
 )
+
+// logic validator codes
+const (
+	IsAnyOf = "is_any_of"
+	IsAllOf = "is_all_of"
+	IsOneOf = "is_one_of"
+)
+
+// cross-field validator codes
+const (
+	IsSameAs                    = "is_same_as"
+	IsDifferentFrom             = "is_different_from"
+	IsLessThanField             = "is_less_than_field"
+	IsLessThanOrEqualToField    = "is_less_than_or_equal_to_field"
+	IsGreaterThanField          = "is_greater_than_field"
+	IsGreaterThanOrEqualToField = "is_greater_than_or_equal_to_field"
+	IsBeforeField               = "is_before_field"
+	IsAfterField                = "is_after_field"
+)
+
+// presence validator codes
+const (
+	IsRequiredIf      = "is_required_if"
+	IsRequiredUnless  = "is_required_unless"
+	IsRequiredWith    = "is_required_with"
+	IsRequiredWithAll = "is_required_with_all"
+	IsRequiredWithout = "is_required_without"
+	IsProhibitedIf    = "is_prohibited_if"
+)
@@ -1,6 +1,9 @@
 package validation
 
 import (
+	"context"
+	"sync"
+
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/validator"
 )
@@ -10,3 +13,42 @@ import (
 func If[T any](condition bool, attribute string, value T, rules ...validation.Rule[T]) validation.ValidatorBuilder {
 	return NewBuilder(validator.If(condition, rules...).SetValue(value)).SetAttribute(attribute)
 }
+
+// When returns a validator builder that validates the given builders when the predicate returns true.
+// The predicate is evaluated once per validation, when the first of the builders' validators runs.
+// Keys set via SetKey are prefixed to the keys of the given builders,
+// and the attribute set via SetAttribute is added to the errors having none.
+func When(predicate func(ctx context.Context) bool, builders ...validation.ValidatorBuilder) validation.ValidatorBuilder {
+	return &wrapperBuilder{
+		builders: builders,
+		wrap: func() func(v validation.Validatable) validation.Validatable {
+			var once sync.Once
+			var ok bool
+			condition := func(ctx context.Context) bool {
+				once.Do(func() {
+					ok = predicate(ctx)
+				})
+				return ok
+			}
+			return func(v validation.Validatable) validation.Validatable {
+				var wrapped validation.Validatable = validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+					if !condition(ctx) {
+						return nil
+					}
+					return v.Validate(ctx, builder)
+				})
+				if _, bail := v.(bailValidatable); bail {
+					wrapped = bailValidatable{wrapped}
+				}
+				return wrapped
+			}
+		},
+	}
+}
+
+// Unless returns a validator builder that validates the given builders when the predicate returns false.
+func Unless(predicate func(ctx context.Context) bool, builders ...validation.ValidatorBuilder) validation.ValidatorBuilder {
+	return When(func(ctx context.Context) bool {
+		return !predicate(ctx)
+	}, builders...)
+}
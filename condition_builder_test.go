@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/errpack"
 	"github.com/gopi-frame/validation/validator"
 	"github.com/stretchr/testify/assert"
 )
@@ -68,3 +69,87 @@ func TestIf(t *testing.T) {
 		}
 	})
 }
+
+func TestWhen(t *testing.T) {
+	var address = struct {
+		Country string
+		State   string
+		Zip     string
+	}{Country: "US"}
+
+	t.Run("rule", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		isLong := func(ctx context.Context, value string) bool {
+			return len(value) > 3
+		}
+		validated := v.Validate(context.Background(),
+			Group("a", "abcd", validator.When(isLong, validator.IsUpper())),
+			Group("b", "abc", validator.When(isLong, validator.IsUpper())),
+			Group("c", "abc", validator.Unless(isLong, validator.IsUpper())),
+		)
+		assert.True(t, validated.FailedAt("a", code.IsUpper))
+		assert.False(t, validated.HasError("b"))
+		assert.True(t, validated.FailedAt("c", code.IsUpper))
+	})
+
+	t.Run("builders", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var calls int
+		isUS := func(ctx context.Context) bool {
+			calls++
+			return address.Country == "US"
+		}
+		validated := v.Validate(context.Background(),
+			NotBlank("country", address.Country),
+			When(isUS, NotBlank("state", address.State), NotBlank("zip", address.Zip)),
+			Unless(isUS, Blank("state", "CA")),
+		)
+		assert.Equal(t, []string{"state", "zip"}, validated.Failed())
+		assert.True(t, validated.FailedAt("state", code.IsNotBlank))
+		assert.False(t, validated.FailedAt("state", code.IsBlank))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("key prefix", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			When(func(ctx context.Context) bool { return true }, NotBlank("state", "")).SetKey("address"),
+		)
+		assert.True(t, validated.FailedAt("address.state", code.IsNotBlank))
+	})
+
+	t.Run("attribute", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		always := func(ctx context.Context) bool { return true }
+		validated := v.Validate(context.Background(),
+			When(always, Group("", "", validator.IsNotBlank[string]())).SetKey("nickname").SetAttribute("nickname"),
+			When(always, NotBlank("state", "")).SetKey("address").SetAttribute("address"),
+		)
+		assert.Equal(t, "nickname should not be blank.", validated.GetError("nickname", code.IsNotBlank).Error())
+		assert.Equal(t, "state should not be blank.", validated.GetError("address.state", code.IsNotBlank).Error())
+	})
+
+	t.Run("severity", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			Warn(Group("", "abc", validator.IsMinLength(6))).SetKey("password").SetAttribute("password"),
+		).(*errpack.Bag)
+		assert.False(t, validated.Fails())
+		assert.Equal(t, "password should have length greater than or equal to 6.", validated.Warnings().GetError("password", code.IsMinLength).Error())
+	})
+}
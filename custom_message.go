@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"sort"
+
+	"github.com/gopi-frame/contract/validation"
+	error2 "github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/translator"
+)
+
+// customMessage is a set of messages by code for the keys matching a pattern.
+type customMessage struct {
+	language  string
+	pattern   error2.Path
+	wildcards int
+	messages  map[string]string
+}
+
+func (m customMessage) match(path error2.Path) bool {
+	if len(m.pattern) != len(path) {
+		return false
+	}
+	for i, segment := range m.pattern {
+		if segment != "*" && segment != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// addCustomMessages adds the messages by key pattern and code, keeping the most specific patterns first.
+func (v *Validator) addCustomMessages(language string, messages map[string]map[string]string) {
+	// messages without a language are of all languages.
+	var lang string
+	if chain := translator.Parents(language); len(chain) > 0 {
+		lang = chain[0]
+	}
+	for pattern, codes := range messages {
+		path := error2.ParsePath(pattern)
+		wildcards := 0
+		for _, segment := range path {
+			if segment == "*" {
+				wildcards++
+			}
+		}
+		v.customMessages = append(v.customMessages, customMessage{
+			language:  lang,
+			pattern:   path,
+			wildcards: wildcards,
+			messages:  codes,
+		})
+	}
+	sort.SliceStable(v.customMessages, func(i, j int) bool {
+		a, b := v.customMessages[i], v.customMessages[j]
+		if a.wildcards != b.wildcards {
+			return a.wildcards < b.wildcards
+		}
+		return a.pattern.String() < b.pattern.String()
+	})
+}
+
+// customMessage returns the custom message of the code for the key in the language,
+// messages of the language and then its parent languages take precedence over the ones of all languages.
+func (v *Validator) customMessage(language string, path error2.Path, code string) (string, bool) {
+	for _, lang := range append(translator.Parents(language), "") {
+		for _, m := range v.customMessages {
+			if m.language != lang || !m.match(path) {
+				continue
+			}
+			if message, ok := m.messages[code]; ok {
+				return message, true
+			}
+		}
+	}
+	return "", false
+}
+
+// applyCustomMessages returns the bag whose errors have their custom messages.
+func (v *Validator) applyCustomMessages(language string, bag *error2.Bag) *error2.Bag {
+	if len(v.customMessages) == 0 {
+		return bag
+	}
+	messages := make(map[string]map[string]string)
+	bag.Each(func(key string, errs validation.Errors) bool {
+		path := bag.Path(key)
+		errs.Each(func(code string, _ validation.Error) bool {
+			if message, ok := v.customMessage(language, path, code); ok {
+				if messages[key] == nil {
+					messages[key] = make(map[string]string)
+				}
+				messages[key][code] = message
+			}
+			return true
+		})
+		return true
+	})
+	if len(messages) > 0 {
+		return bag.SetMessages(messages).(*error2.Bag)
+	}
+	return bag
+}
@@ -1,42 +1,212 @@
 package errpack
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"text/template"
 
 	"github.com/gopi-frame/contract/validation"
 )
 
+// ErrorParam is a param keeping its original value, so that message templates can format it,
+// e.g. {{join ", " .values}} or {{date "2006-01-02" .time}}.
 type ErrorParam struct {
 	key   string
-	value string
+	value any
 }
 
 func (e *ErrorParam) Key() string {
 	return e.key
 }
 
+// Value returns the value formatted with %v.
 func (e *ErrorParam) Value() string {
+	return fmt.Sprintf("%v", e.value)
+}
+
+// Raw returns the original value.
+func (e *ErrorParam) Raw() any {
 	return e.value
 }
 
 func NewParam(key string, value any) *ErrorParam {
 	return &ErrorParam{
 		key:   key,
-		value: fmt.Sprintf("%v", value),
+		value: value,
+	}
+}
+
+// CountParam is a param holding a count, its value decides the plural form of the message,
+// see [validation.Translator.P].
+type CountParam struct {
+	key   string
+	count any
+}
+
+func NewCountParam(key string, count any) *CountParam {
+	return &CountParam{
+		key:   key,
+		count: count,
 	}
 }
 
+func (c *CountParam) Key() string {
+	return c.key
+}
+
+func (c *CountParam) Value() string {
+	return fmt.Sprintf("%v", c.count)
+}
+
+// Count returns the count.
+func (c *CountParam) Count() any {
+	return c.count
+}
+
+// Raw returns the count.
+func (c *CountParam) Raw() any {
+	return c.count
+}
+
+// ErrorsParam is a param holding nested errors, e.g. the failures of the rules of a composite rule.
+// Its value is the messages of the nested errors joined by "; ".
+type ErrorsParam struct {
+	key    string
+	errors []validation.Error
+}
+
+func NewErrorsParam(key string, errs ...validation.Error) *ErrorsParam {
+	return &ErrorsParam{
+		key:    key,
+		errors: errs,
+	}
+}
+
+func (e *ErrorsParam) Key() string {
+	return e.key
+}
+
+func (e *ErrorsParam) Value() string {
+	messages := make([]string, 0, len(e.errors))
+	for _, err := range e.errors {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Errors returns the nested errors.
+func (e *ErrorsParam) Errors() []validation.Error {
+	return e.errors
+}
+
+// withParam returns a copy of the param whose nested errors not having the param yet have it.
+func (e *ErrorsParam) withParam(param validation.Param) *ErrorsParam {
+	errs := make([]validation.Error, len(e.errors))
+	for i, err := range e.errors {
+		if !err.HasParam(param.Key()) {
+			err = err.AddParam(param)
+		}
+		errs[i] = err
+	}
+	return &ErrorsParam{key: e.key, errors: errs}
+}
+
+// maxTemplates is the number of messages cached by [Templates],
+// so that messages built at runtime, e.g. custom messages set per error, don't grow the cache without bound.
+const maxTemplates = 1024
+
+// Templates parses message templates with the functions returned by [Funcs] and additional functions,
+// the parsed templates are cached by message, parsing errors included, so that each message is only parsed once.
+// Once the cache is full, other messages are parsed on each use.
+type Templates struct {
+	funcs template.FuncMap
+	mu    sync.RWMutex
+	cache map[string]parsedTemplate
+}
+
+type parsedTemplate struct {
+	tmpl *template.Template
+	err  error
+}
+
+// NewTemplates returns templates parsed with the given additional functions, which may be nil.
+func NewTemplates(funcs template.FuncMap) *Templates {
+	return &Templates{
+		funcs: funcs,
+		cache: make(map[string]parsedTemplate),
+	}
+}
+
+// Parse parses the message template, see [Templates].
+func (t *Templates) Parse(message string) (*template.Template, error) {
+	t.mu.RLock()
+	parsed, ok := t.cache[message]
+	t.mu.RUnlock()
+	if ok {
+		return parsed.tmpl, parsed.err
+	}
+	tmpl, err := template.New("").Funcs(Funcs()).Funcs(t.funcs).Parse(message)
+	t.mu.Lock()
+	if len(t.cache) < maxTemplates {
+		t.cache[message] = parsedTemplate{tmpl, err}
+	}
+	t.mu.Unlock()
+	return tmpl, err
+}
+
+// defaultTemplates are the templates of errors without additional functions.
+var defaultTemplates = NewTemplates(nil)
+
+// Parse parses the message template with the functions returned by [Funcs] and the given functions, which may be nil.
+// Templates without additional functions are cached, functions used repeatedly should be bound to [Templates] instead.
+func Parse(message string, funcs template.FuncMap) (*template.Template, error) {
+	if funcs == nil {
+		return defaultTemplates.Parse(message)
+	}
+	return NewTemplates(funcs).Parse(message)
+}
+
+func render(templates *Templates, message string, params map[string]any) (string, error) {
+	if templates == nil {
+		templates = defaultTemplates
+	}
+	tmpl, err := templates.Parse(message)
+	if err != nil {
+		return "", err
+	}
+	sb := new(strings.Builder)
+	if err := tmpl.Execute(sb, params); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// DefaultAttribute is the attribute rendered in the messages of errors without an attribute param,
+// it is translated as "attribute.value" like other attributes.
+const DefaultAttribute = "value"
+
+// Error is an immutable validation error, its setters return a modified copy and leave the receiver untouched,
+// so that errors can be shared, e.g. returned by an [validation.ErrorBuilder] from a pool, and used concurrently.
 type Error struct {
-	code            string
-	message         string
-	customMessage   string
-	params          []validation.Param
-	translator      validation.Translator
-	renderedMessage string
+	code          string
+	message       string
+	customMessage string
+	params        []validation.Param
+	translator    validation.Translator
+	errorHandler  func(err error)
+	templates     *Templates
+	severity      Severity
+	cause         error
+	// key is the key of the bag holding the error, see [Error.Key].
+	key string
+	// rendered caches the rendered message, copies start without it.
+	rendered atomic.Pointer[string]
 }
 
 func NewError(code string, message string, params ...validation.Param) *Error {
@@ -47,48 +217,142 @@ func NewError(code string, message string, params ...validation.Param) *Error {
 	}
 }
 
+// clone returns a copy of the error without the rendered message.
+func (e *Error) clone() *Error {
+	return &Error{
+		code:          e.code,
+		message:       e.message,
+		customMessage: e.customMessage,
+		params:        e.params,
+		translator:    e.translator,
+		errorHandler:  e.errorHandler,
+		templates:     e.templates,
+		severity:      e.severity,
+		cause:         e.cause,
+		key:           e.key,
+	}
+}
+
 func (e *Error) Code() string {
 	return e.code
 }
 
 func (e *Error) Error() string {
-	if e.renderedMessage != "" {
-		return e.renderedMessage
+	if rendered := e.rendered.Load(); rendered != nil {
+		return *rendered
 	}
+	rendered := e.render()
+	e.rendered.Store(&rendered)
+	return rendered
+}
+
+func (e *Error) render() string {
 	params := map[string]any{}
 	for _, param := range e.params {
-		var value = param.Value()
-		if param.Key() == "attribute" {
-			if e.translator != nil {
-				value = e.translator.T(fmt.Sprintf("attribute.%s", param.Value()), nil)
+		var value any = param.Value()
+		if raw, ok := param.(interface{ Raw() any }); ok {
+			value = raw.Raw()
+		}
+		switch param.Key() {
+		case "attribute", "other":
+			value = e.attributeName(param.Value())
+		case "others":
+			if names, ok := value.([]string); ok {
+				translated := make([]string, len(names))
+				for i, name := range names {
+					translated[i] = e.attributeName(name)
+				}
+				value = translated
 			}
 		}
 		params[param.Key()] = value
 	}
-	var message string
+	if _, ok := params["attribute"]; !ok {
+		// errors without an attribute, e.g. of a value validated alone, are about the "value".
+		params["attribute"] = e.attributeName(DefaultAttribute)
+	}
+	if e.key != "" {
+		if translated := e.translate(fmt.Sprintf("custom.%s.%s", e.key, e.code), params); translated != "" {
+			return translated
+		}
+	}
 	if e.customMessage != "" {
-		message = e.customMessage
-	} else if e.translator != nil {
-		e.renderedMessage = e.translator.T(e.code, params)
-		if e.renderedMessage == "" {
-			message = e.message
-		} else {
-			return e.renderedMessage
+		rendered, err := render(e.templates, e.customMessage, params)
+		if err == nil {
+			return rendered
+		}
+		e.report(err)
+	}
+	if translated := e.translate(e.code, params); translated != "" {
+		return translated
+	}
+	rendered, err := render(e.templates, e.message, params)
+	if err != nil {
+		// the default message is returned unrendered as the last resort.
+		e.report(err)
+		return e.message
+	}
+	return rendered
+}
+
+// translate returns the translated message of the key, in its plural form if the error has a [CountParam],
+// or an empty string if there is no translator or message.
+func (e *Error) translate(key string, params map[string]any) string {
+	if e.translator == nil {
+		return ""
+	}
+	if count, ok := e.count(); ok {
+		return e.translator.P(key, count, params)
+	}
+	return e.translator.T(key, params)
+}
+
+// attributeName returns the translated name of the attribute, attributes without a translated name are rendered as is.
+func (e *Error) attributeName(attribute string) string {
+	if e.translator != nil {
+		if name := e.translator.T(fmt.Sprintf("attribute.%s", attribute), nil); name != "" {
+			return name
 		}
-	} else {
-		message = e.message
 	}
-	var sb = new(strings.Builder)
-	if err := template.Must(template.New("").Parse(message)).Execute(sb, params); err != nil {
-		panic(err)
+	return attribute
+}
+
+// report reports a rendering error to the error handler if any.
+func (e *Error) report(err error) {
+	if e.errorHandler != nil {
+		e.errorHandler(fmt.Errorf("errpack: failed to render message of %s: %w", e.code, err))
+	}
+}
+
+// count returns the count of the first [CountParam].
+func (e *Error) count() (any, bool) {
+	for _, param := range e.params {
+		if c, ok := param.(*CountParam); ok {
+			return c.Count(), true
+		}
 	}
-	e.renderedMessage = sb.String()
-	return e.renderedMessage
+	return nil, false
 }
 
+// Key returns the key of the bag holding the error, or an empty string.
+// The translated message "custom.<key>.<code>" of the error, e.g. "custom.email.is_match" of a translation catalog,
+// takes precedence over its custom message and the translated message of its code.
+func (e *Error) Key() string {
+	return e.key
+}
+
+// withKey returns a copy of the error held by a bag at the key.
+func (e *Error) withKey(key string) *Error {
+	c := e.clone()
+	c.key = key
+	return c
+}
+
+// SetMessage returns a copy of the error with the custom message.
 func (e *Error) SetMessage(message string) validation.Error {
-	e.customMessage = message
-	return e
+	c := e.clone()
+	c.customMessage = message
+	return c
 }
 
 func (e *Error) Message() string {
@@ -108,206 +372,444 @@ func (e *Error) HasParam(key string) bool {
 	return false
 }
 
+// AddParam returns a copy of the error with the param, replacing the param of the same key.
+// The param is also added to the nested errors not having it yet, see [ErrorsParam].
 func (e *Error) AddParam(param validation.Param) validation.Error {
-	for i := range e.params {
-		if e.params[i].Key() == param.Key() {
-			e.params[i] = param
-			return e
+	c := e.clone()
+	c.params = make([]validation.Param, 0, len(e.params)+1)
+	replaced := false
+	for _, p := range e.params {
+		if p.Key() == param.Key() {
+			c.params = append(c.params, param)
+			replaced = true
+			continue
 		}
+		if nested, ok := p.(*ErrorsParam); ok {
+			p = nested.withParam(param)
+		}
+		c.params = append(c.params, p)
 	}
-	e.params = append(e.params, param)
-	return e
+	if !replaced {
+		c.params = append(c.params, param)
+	}
+	return c
 }
 
-func (e *Error) SetTranslator(translator validation.Translator) validation.Error {
-	e.translator = translator
-	return e
+// SetErrorHandler returns a copy of the error with the handler receiving the errors of rendering the message,
+// e.g. a malformed custom message. Rendering falls back to the next message instead of failing:
+// the custom message, the translated message, and then the default message.
+func (e *Error) SetErrorHandler(handler func(err error)) *Error {
+	c := e.clone()
+	c.errorHandler = handler
+	return c
+}
+
+// SetFuncs returns a copy of the error with additional functions for rendering the custom and the default message, see [Funcs].
+// Errors sharing the functions should share their [Templates] instead, see [Error.SetTemplates].
+func (e *Error) SetFuncs(funcs template.FuncMap) *Error {
+	return e.SetTemplates(NewTemplates(funcs))
+}
+
+// SetTemplates returns a copy of the error rendering the custom and the default message with the templates.
+func (e *Error) SetTemplates(templates *Templates) *Error {
+	c := e.clone()
+	c.templates = templates
+	return c
+}
+
+// SetTranslator returns a copy of the error with the translator.
+func (e *Error) SetTranslator(translator validation.Translator) *Error {
+	c := e.clone()
+	c.translator = translator
+	return c
+}
+
+// Severity returns the severity of the error, [SeverityError] by default.
+func (e *Error) Severity() Severity {
+	if e.severity == "" {
+		return SeverityError
+	}
+	return e.severity
+}
+
+// SetSeverity returns a copy of the error with the severity, errors of other severities than [SeverityError] don't fail the validation.
+func (e *Error) SetSeverity(severity Severity) *Error {
+	c := e.clone()
+	c.severity = severity
+	return c
+}
+
+// Cause returns the underlying cause of the error, e.g. the error of parsing the value, or nil.
+func (e *Error) Cause() error {
+	return e.cause
+}
+
+// SetCause returns a copy of the error with the underlying cause, which is matched by [errors.Is] and [errors.As].
+func (e *Error) SetCause(cause error) *Error {
+	c := e.clone()
+	c.cause = cause
+	return c
+}
+
+// Unwrap returns the cause of the error and the nested errors of its params, see [ErrorsParam].
+func (e *Error) Unwrap() []error {
+	var errs []error
+	if e.cause != nil {
+		errs = append(errs, e.cause)
+	}
+	for _, param := range e.params {
+		if nested, ok := param.(*ErrorsParam); ok {
+			for _, err := range nested.Errors() {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// Is reports whether the target is the [Code] of the error.
+func (e *Error) Is(target error) bool {
+	c, ok := target.(Code)
+	return ok && string(c) == e.code
 }
 
 func (e *Error) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
+		Code     string   `json:"code"`
+		Message  string   `json:"message"`
+		Severity Severity `json:"severity"`
 	}{
-		Code:    e.Code(),
-		Message: e.Error(),
+		Code:     e.Code(),
+		Message:  e.Error(),
+		Severity: e.Severity(),
 	})
 }
 
-type Errors map[string]validation.Error
+// Errors is a list of errors keyed by their insertion position,
+// so that the errors are iterated in the order they were added.
+// Errors with the same code are all kept.
+type Errors map[int]validation.Error
 
 func NewErrors() Errors {
-	return make(map[string]validation.Error)
+	return make(map[int]validation.Error)
+}
+
+// newErrors returns the errors of the list.
+func newErrors(list []validation.Error) Errors {
+	errs := make(Errors, len(list))
+	for i, err := range list {
+		errs[i] = err
+	}
+	return errs
+}
+
+// list returns the errors in the order they were added.
+func (e Errors) list() []validation.Error {
+	positions := make([]int, 0, len(e))
+	for i := range e {
+		positions = append(positions, i)
+	}
+	sort.Ints(positions)
+	errs := make([]validation.Error, 0, len(e))
+	for _, i := range positions {
+		errs = append(errs, e[i])
+	}
+	return errs
 }
 
 func (e Errors) Error() string {
 	errs := make([]string, 0, len(e))
-	for _, err := range e {
+	for _, err := range e.list() {
 		errs = append(errs, err.Error())
 	}
 	return strings.Join(errs, ", ")
 }
 
+// Unwrap returns the errors in the order they were added.
+func (e Errors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e.list() {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Add adds the error after the last one.
 func (e Errors) Add(err validation.Error) {
-	if _, ok := e[err.Code()]; ok {
-		return
-	} else {
-		e[err.Code()] = err
+	position := len(e)
+	for i := range e {
+		if i >= position {
+			position = i + 1
+		}
 	}
+	e[position] = err
 }
 
+// Get returns the first error with the given code.
 func (e Errors) Get(code string) validation.Error {
-	if e == nil {
-		return nil
+	for _, err := range e.list() {
+		if err.Code() == code {
+			return err
+		}
 	}
-	return e[code]
+	return nil
 }
 
-func (e Errors) Has(code string) bool {
-	if e == nil {
-		return false
+// GetAll returns all errors with the given code.
+func (e Errors) GetAll(code string) []validation.Error {
+	var errs []validation.Error
+	for _, err := range e.list() {
+		if err.Code() == code {
+			errs = append(errs, err)
+		}
 	}
-	_, ok := e[code]
-	return ok
+	return errs
+}
+
+func (e Errors) Has(code string) bool {
+	return e.Get(code) != nil
 }
 
 func (e Errors) Each(f func(key string, err validation.Error) bool) {
-	for code, err := range e {
-		if !f(code, err) {
+	for _, err := range e.list() {
+		if !f(err.Code(), err) {
 			break
 		}
 	}
 }
 
 func (e Errors) MarshalJSON() ([]byte, error) {
-	var errs = make([]validation.Error, 0, len(e))
-	for _, err := range e {
-		errs = append(errs, err)
-	}
-	return json.Marshal(errs)
+	return json.Marshal(e.list())
 }
 
+// Bag is a collection of errors grouped by keys.
+// Keys are iterated in the order they were added.
+// Each key is the dotted form of a path (see [Path.String]), the segments are kept and returned by [Bag.Path].
 type Bag struct {
-	errors         map[string]Errors
+	err            error
+	keys           []string
+	paths          map[string]Path
+	errors         map[string][]validation.Error
 	messages       map[string][]string
 	customMessages map[string]map[string]string
 }
 
 func NewBag() *Bag {
 	return &Bag{
-		errors:         make(map[string]Errors),
+		errors:         make(map[string][]validation.Error),
+		paths:          make(map[string]Path),
 		messages:       make(map[string][]string),
 		customMessages: make(map[string]map[string]string),
 	}
 }
 
+// SetErr records the error that interrupted the validation, e.g. the context being canceled.
+func (e *Bag) SetErr(err error) {
+	e.err = err
+}
+
+// Err returns the error that interrupted the validation.
+// It makes [Bag.Fails] report true, and is matched by [errors.Is] on the bag, e.g. errors.Is(bag, context.Canceled),
+// while [Bag.Failed] only returns the keys of the errors collected before the interruption.
+func (e *Bag) Err() error {
+	return e.err
+}
+
+// Fails reports whether the bag has errors of [SeverityError], warnings and notices don't fail.
+// An interrupted validation (see [Bag.Err]) fails as well, as not all values were validated.
 func (e *Bag) Fails() bool {
-	return len(e.Failed()) > 0
+	return e.err != nil || len(e.Failed()) > 0
 }
 
+// Failed returns the keys having errors of [SeverityError].
 func (e *Bag) Failed() []string {
 	var keys []string
-	for key := range e.errors {
-		if len(e.errors[key]) > 0 {
+	for _, key := range e.keys {
+		if e.failed(key) {
 			keys = append(keys, key)
 		}
 	}
 	return keys
 }
 
+func (e *Bag) failed(key string) bool {
+	for _, err := range e.errors[key] {
+		if SeverityOf(err) == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// FailedAt reports whether the key has errors of [SeverityError] with any of the codes, or with any code if no codes are given.
 func (e *Bag) FailedAt(key string, codes ...string) bool {
+	key = canonicalKey(key)
 	if e.errors == nil {
 		return false
 	}
 	if len(codes) == 0 {
-		return len(e.errors[key]) > 0
+		return e.failed(key)
 	}
+	errs := e.errors[key]
 	for _, code := range codes {
-		if ok := e.errors[key].Has(code); ok {
-			return true
+		for _, err := range errs {
+			if err.Code() == code && SeverityOf(err) == SeverityError {
+				return true
+			}
 		}
 	}
 	return false
 }
 
+// HasError reports whether the key has errors of [SeverityError].
 func (e *Bag) HasError(key string) bool {
+	key = canonicalKey(key)
 	if e.errors == nil {
 		return false
 	}
-	return len(e.errors[key]) > 0
+	return e.failed(key)
+}
+
+// Warnings returns a bag of the errors of [SeverityWarning] and [SeverityNotice], which don't fail the validation.
+func (e *Bag) Warnings() *Bag {
+	warnings := NewBag()
+	for _, key := range e.keys {
+		for _, err := range e.errors[key] {
+			if SeverityOf(err) != SeverityError {
+				warnings.AddErrorAt(e.Path(key), err)
+			}
+		}
+	}
+	return warnings
+}
+
+// HasWarnings reports whether the bag has errors of [SeverityWarning] or [SeverityNotice].
+func (e *Bag) HasWarnings() bool {
+	for _, key := range e.keys {
+		for _, err := range e.errors[key] {
+			if SeverityOf(err) != SeverityError {
+				return true
+			}
+		}
+	}
+	return false
 }
 
+// AddError adds the error at the dotted key, see [ParsePath].
+// The errors of a nested bag are added at their keys prefixed by the key.
 func (e *Bag) AddError(key string, err validation.Error) {
+	e.AddErrorAt(ParsePath(key), err)
+}
+
+// AddErrorAt adds the error at the path.
+// The errors of a nested bag are added at their paths prefixed by the path.
+func (e *Bag) AddErrorAt(path Path, err validation.Error) {
 	if e.errors == nil {
-		e.errors = make(map[string]Errors)
+		e.errors = make(map[string][]validation.Error)
+	}
+	if e.paths == nil {
+		e.paths = make(map[string]Path)
 	}
 	var errorBag validation.ErrorBag
 	if errors.As(err, &errorBag) {
+		bag, _ := errorBag.(*Bag)
 		errorBag.Each(func(k string, errs validation.Errors) bool {
-			k0 := key
-			if k != "" {
-				k0 = strings.Trim(fmt.Sprintf("%s.%s", key, k), ".")
+			var sub Path
+			if bag != nil {
+				sub = bag.Path(k)
+			} else {
+				sub = ParsePath(k)
 			}
+			full := path.Join(sub)
 			errs.Each(func(code string, err validation.Error) bool {
-				e.AddError(k0, err)
+				e.AddErrorAt(full, err)
 				return true
 			})
 			return true
 		})
 	} else {
-		if e.errors[key] == nil {
-			e.errors[key] = NewErrors()
+		key := path.String()
+		if _, ok := e.errors[key]; !ok {
+			e.keys = append(e.keys, key)
+			e.paths[key] = path
+		}
+		if e2, ok := err.(*Error); ok && e2.key != key {
+			err = e2.withKey(key)
 		}
-		e.errors[key].Add(err)
+		e.errors[key] = append(e.errors[key], err)
+	}
+}
+
+// Path returns the path segments of the key.
+func (e *Bag) Path(key string) Path {
+	key = canonicalKey(key)
+	if path, ok := e.paths[key]; ok {
+		return path
+	}
+	return ParsePath(key)
+}
+
+// Paths returns the paths of the keys, warnings included, in the order they were added.
+func (e *Bag) Paths() []Path {
+	var paths []Path
+	for _, key := range e.keys {
+		paths = append(paths, e.Path(key))
 	}
+	return paths
 }
 
 func (e *Bag) GetAllErrors() map[string]validation.Errors {
 	errs := make(map[string]validation.Errors)
 	for key, errorList := range e.errors {
-		errs[key] = NewErrors()
-		for _, err := range errorList {
-			errs[key].Add(err)
-		}
+		errs[key] = newErrors(errorList)
 	}
 	return errs
 }
 
 func (e *Bag) GetErrors(key string) validation.Errors {
-	return e.errors[key]
+	key = canonicalKey(key)
+	return newErrors(e.errors[key])
 }
 
 func (e *Bag) GetError(key string, code string) validation.Error {
-	return e.errors[key].Get(code)
+	key = canonicalKey(key)
+	for _, err := range e.errors[key] {
+		if err.Code() == code {
+			return err
+		}
+	}
+	return nil
 }
 
 // GetMessages returns the error messages for all keys.
 // Once the messages are retrieved, they are stored in the messages map.
 func (e *Bag) GetMessages() map[string][]string {
 	var errorBag = make(map[string][]string)
-	for key := range e.errors {
+	for _, key := range e.keys {
 		errorBag[key] = e.GetMessage(key)
 	}
 	return errorBag
 }
 
-// SetMessages sets custom error messages
+// SetMessages returns a copy of the bag whose errors have the custom messages of their keys and codes,
+// the bag is left untouched.
 func (e *Bag) SetMessages(messages map[string]map[string]string) validation.ErrorBag {
-	for key, errs := range e.errors {
-		if customMessages, ok := messages[key]; ok {
-			for code, err := range errs {
-				if customMessage, ok := customMessages[code]; ok {
-					e.errors[key][code] = err.SetMessage(customMessage)
-				}
-			}
-		}
+	customMessages := make(map[string]map[string]string, len(messages))
+	for key, m := range messages {
+		customMessages[canonicalKey(key)] = m
 	}
-	return e
+	return e.mapErrors(func(key string, err validation.Error) validation.Error {
+		if customMessage, ok := customMessages[key][err.Code()]; ok {
+			return err.SetMessage(customMessage)
+		}
+		return err
+	})
 }
 
 // GetMessage returns the error message for the given key.
 // Once the message is retrieved, it is stored in the messages map.
 func (e *Bag) GetMessage(key string) []string {
+	key = canonicalKey(key)
 	if e.messages == nil {
 		e.messages = make(map[string][]string)
 	}
@@ -323,28 +825,66 @@ func (e *Bag) GetMessage(key string) []string {
 }
 
 func (e *Bag) Each(f func(key string, errs validation.Errors) bool) {
-	for key, errs := range e.errors {
-		if !f(key, errs) {
+	for _, key := range e.keys {
+		if !f(key, newErrors(e.errors[key])) {
 			break
 		}
 	}
 }
 
+// MarshalJSON encodes the bag as a JSON object whose keys are in insertion order.
 func (e *Bag) MarshalJSON() ([]byte, error) {
-	return json.Marshal(e.errors)
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range e.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(e.errors[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
 }
 
 func (e *Bag) Error() string {
-	messages := e.GetMessages()
 	sb := new(strings.Builder)
-	for key, msgs := range messages {
-		for _, msg := range msgs {
+	for _, key := range e.keys {
+		for _, msg := range e.GetMessage(key) {
 			sb.WriteString(fmt.Sprintf("%s: %s\n", key, msg))
 		}
 	}
+	if e.err != nil {
+		sb.WriteString(fmt.Sprintf("validation interrupted: %s\n", e.err))
+	}
 	return sb.String()
 }
 
+// Unwrap returns the errors of all keys in the order they were added, warnings included,
+// and then the error interrupting the validation if any,
+// so that [errors.Is] and [errors.As] match any of them, e.g. errors.Is(bag, Code(code.IsNotBlank)) or errors.Is(bag, context.Canceled).
+func (e *Bag) Unwrap() []error {
+	var errs []error
+	for _, key := range e.keys {
+		for _, err := range e.errors[key] {
+			errs = append(errs, err)
+		}
+	}
+	if e.err != nil {
+		errs = append(errs, e.err)
+	}
+	return errs
+}
+
 func (e *Bag) SetMessage(_ string) validation.Error {
 	return e
 }
@@ -361,20 +901,20 @@ func (e *Bag) Params() []validation.Param {
 	return nil
 }
 
+// AddParam returns a copy of the bag whose errors have the param, unless they already have a param with the same key,
+// the bag is left untouched.
 func (e *Bag) AddParam(param validation.Param) validation.Error {
-	for key := range e.errors {
-		for i := range e.errors[key] {
-			if !e.errors[key][i].HasParam(param.Key()) {
-				e.errors[key][i] = e.errors[key][i].AddParam(param)
-			}
+	return e.mapErrors(func(_ string, err validation.Error) validation.Error {
+		if !err.HasParam(param.Key()) {
+			return err.AddParam(param)
 		}
-	}
-	return e
+		return err
+	})
 }
 
 func (e *Bag) HasParam(key string) bool {
-	for k := range e.errors {
-		for _, err := range e.errors[k] {
+	for _, errs := range e.errors {
+		for _, err := range errs {
 			if !err.HasParam(key) {
 				return false
 			}
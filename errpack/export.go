@@ -0,0 +1,162 @@
+package errpack
+
+import (
+	"github.com/gopi-frame/contract/validation"
+)
+
+// ProblemContentType is the media type of [Problem] documents.
+const ProblemContentType = "application/problem+json"
+
+// JSONAPIContentType is the media type of [JSONAPIDocument] documents.
+const JSONAPIContentType = "application/vnd.api+json"
+
+// FieldError is an error of a field in the flat list returned by [ToList].
+type FieldError struct {
+	Field    string         `json:"field"`
+	Code     string         `json:"code"`
+	Message  string         `json:"message"`
+	Severity Severity       `json:"severity"`
+	Params   map[string]any `json:"params,omitempty"`
+}
+
+// ToList returns the errors of the bag as a flat list, in the order of the bag, warnings included.
+func ToList(bag validation.ErrorBag) []FieldError {
+	list := make([]FieldError, 0)
+	each(bag, func(key string, err validation.Error) {
+		list = append(list, FieldError{
+			Field:    key,
+			Code:     err.Code(),
+			Message:  err.Error(),
+			Severity: SeverityOf(err),
+			Params:   ParamValues(err),
+		})
+	})
+	return list
+}
+
+// Problem is an RFC 7807 problem details document, the errors of the bag are its invalid-params extension member.
+type Problem struct {
+	Type          string         `json:"type,omitempty"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status,omitempty"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params"`
+}
+
+// InvalidParam is an entry of the invalid-params member of a [Problem].
+type InvalidParam struct {
+	Name     string         `json:"name"`
+	Reason   string         `json:"reason"`
+	Code     string         `json:"code"`
+	Severity Severity       `json:"severity"`
+	Params   map[string]any `json:"params,omitempty"`
+}
+
+// ToProblem returns an RFC 7807 problem details document of the bag, e.g.
+//
+//	problem := errpack.ToProblem(bag, http.StatusUnprocessableEntity, "Your request parameters didn't validate.")
+//	w.Header().Set("Content-Type", errpack.ProblemContentType)
+//	json.NewEncoder(w).Encode(problem)
+//
+// Type, Detail and Instance can be set on the returned document. Warnings are included with their severity.
+func ToProblem(bag validation.ErrorBag, status int, title string) *Problem {
+	problem := &Problem{
+		Title:         title,
+		Status:        status,
+		InvalidParams: make([]InvalidParam, 0),
+	}
+	each(bag, func(key string, err validation.Error) {
+		problem.InvalidParams = append(problem.InvalidParams, InvalidParam{
+			Name:     key,
+			Reason:   err.Error(),
+			Code:     err.Code(),
+			Severity: SeverityOf(err),
+			Params:   ParamValues(err),
+		})
+	})
+	return problem
+}
+
+// JSONAPIDocument is a JSON:API document holding errors.
+type JSONAPIDocument struct {
+	Errors []JSONAPIError `json:"errors"`
+}
+
+// JSONAPIError is a JSON:API error object, the params of the error are its meta member.
+type JSONAPIError struct {
+	Status string         `json:"status,omitempty"`
+	Code   string         `json:"code"`
+	Detail string         `json:"detail"`
+	Source JSONAPISource  `json:"source"`
+	Meta   map[string]any `json:"meta,omitempty"`
+}
+
+// JSONAPISource is the source member of a [JSONAPIError].
+type JSONAPISource struct {
+	Pointer string `json:"pointer"`
+}
+
+// ToJSONAPI returns a JSON:API document of the errors of the bag,
+// their source pointers are the keys under "/data/attributes", e.g. "items.0.name" is "/data/attributes/items/0/name".
+// Status is the HTTP status of each error, it is omitted if empty.
+// Warnings and notices are not errors in the sense of JSON:API, so they are left out.
+func ToJSONAPI(bag validation.ErrorBag, status string) *JSONAPIDocument {
+	document := &JSONAPIDocument{Errors: make([]JSONAPIError, 0)}
+	each(bag, func(key string, err validation.Error) {
+		if SeverityOf(err) != SeverityError {
+			return
+		}
+		document.Errors = append(document.Errors, JSONAPIError{
+			Status: status,
+			Code:   err.Code(),
+			Detail: err.Error(),
+			Source: JSONAPISource{Pointer: "/data/attributes" + pathOf(bag, key).Pointer()},
+			Meta:   ParamValues(err),
+		})
+	})
+	return document
+}
+
+// pathOf returns the path segments of the key of the bag.
+func pathOf(bag validation.ErrorBag, key string) Path {
+	if b, ok := bag.(*Bag); ok {
+		return b.Path(key)
+	}
+	return ParsePath(key)
+}
+
+// ParamValues returns the params of the error by key, keeping their original values (see [ErrorParam.Raw]),
+// nested errors (see [ErrorsParam]) are returned as [FieldError] without field.
+func ParamValues(err validation.Error) map[string]any {
+	params := err.Params()
+	if len(params) == 0 {
+		return nil
+	}
+	values := make(map[string]any, len(params))
+	for _, param := range params {
+		switch p := param.(type) {
+		case *ErrorsParam:
+			nested := make([]FieldError, 0, len(p.Errors()))
+			for _, e := range p.Errors() {
+				nested = append(nested, FieldError{Code: e.Code(), Message: e.Error(), Params: ParamValues(e)})
+			}
+			values[p.Key()] = nested
+		case interface{ Raw() any }:
+			values[param.Key()] = p.Raw()
+		default:
+			values[param.Key()] = param.Value()
+		}
+	}
+	return values
+}
+
+func each(bag validation.ErrorBag, f func(key string, err validation.Error)) {
+	bag.Each(func(key string, errs validation.Errors) bool {
+		errs.Each(func(_ string, err validation.Error) bool {
+			f(key, err)
+			return true
+		})
+		return true
+	})
+}
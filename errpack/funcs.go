@@ -0,0 +1,127 @@
+package errpack
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Funcs returns the functions available in message templates:
+//
+//   - join: joins the elements of a slice with a separator, e.g. {{join ", " .values}}
+//   - quote: quotes a value, or each element of a slice, e.g. {{quote .values | join ", "}}
+//   - upper, lower: changes the case of a value, e.g. {{upper .attribute}}
+//   - date: formats a [time.Time] with a layout, e.g. {{date "2006-01-02" .time}}
+//   - number: formats a number with thousands separators, e.g. {{number .value}} renders 1234567.5 as 1,234,567.5
+//   - plural: picks the singular form if the count is 1, e.g. {{plural .count "element" "elements"}},
+//     see translator.Translator.P for plural forms of other languages
+//
+// The returned map is a copy, so it can be extended safely.
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"join":   join,
+		"quote":  quote,
+		"upper":  upper,
+		"lower":  lower,
+		"date":   date,
+		"number": number,
+		"plural": plural,
+	}
+}
+
+func join(sep string, values any) string {
+	v := reflect.ValueOf(values)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return fmt.Sprint(values)
+	}
+	elements := make([]string, v.Len())
+	for i := range elements {
+		elements[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+	return strings.Join(elements, sep)
+}
+
+func quote(value any) any {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return strconv.Quote(fmt.Sprint(value))
+	}
+	quoted := make([]string, v.Len())
+	for i := range quoted {
+		quoted[i] = strconv.Quote(fmt.Sprint(v.Index(i).Interface()))
+	}
+	return quoted
+}
+
+func upper(value any) string {
+	return strings.ToUpper(fmt.Sprint(value))
+}
+
+func lower(value any) string {
+	return strings.ToLower(fmt.Sprint(value))
+}
+
+func date(layout string, value any) string {
+	switch t := value.(type) {
+	case time.Time:
+		return t.Format(layout)
+	case *time.Time:
+		if t != nil {
+			return t.Format(layout)
+		}
+		return ""
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func number(value any) string {
+	var s string
+	switch n := value.(type) {
+	case float32:
+		if math.IsInf(float64(n), 0) || math.IsNaN(float64(n)) {
+			return fmt.Sprint(n)
+		}
+		s = strconv.FormatFloat(float64(n), 'f', -1, 32)
+	case float64:
+		if math.IsInf(n, 0) || math.IsNaN(n) {
+			return fmt.Sprint(n)
+		}
+		s = strconv.FormatFloat(n, 'f', -1, 64)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		s = fmt.Sprint(n)
+	default:
+		return fmt.Sprint(value)
+	}
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(s, ".")
+	sb := new(strings.Builder)
+	sb.WriteString(sign)
+	for i, c := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(c)
+	}
+	if hasFraction {
+		sb.WriteByte('.')
+		sb.WriteString(fraction)
+	}
+	return sb.String()
+}
+
+func plural(count any, singular, plural string) string {
+	switch fmt.Sprint(count) {
+	case "1", "-1":
+		return singular
+	default:
+		return plural
+	}
+}
@@ -0,0 +1,101 @@
+package errpack
+
+import (
+	"strings"
+)
+
+// Path is the key of an error as path segments, e.g. []string{"items", "0", "name"}.
+// Segments may contain dots, e.g. the keys of a map, so a path is not ambiguous unlike its dotted form.
+type Path []string
+
+// ParsePath parses a dotted path, the reverse of [Path.String].
+// A dot preceded by a backslash is part of the segment, e.g. `hosts.example\.com` is []string{"hosts", "example.com"},
+// and so is a backslash preceded by a backslash, other backslashes are kept as is.
+func ParsePath(key string) Path {
+	if key == "" {
+		return nil
+	}
+	var path Path
+	var segment strings.Builder
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; {
+		case c == '\\' && i+1 < len(key) && (key[i+1] == '.' || key[i+1] == '\\'):
+			i++
+			segment.WriteByte(key[i])
+		case c == '.':
+			path = append(path, segment.String())
+			segment.Reset()
+		default:
+			segment.WriteByte(c)
+		}
+	}
+	return append(path, segment.String())
+}
+
+// canonicalKey returns the key as stored by a bag, see [Path.String],
+// which differs from the given one if it escapes a backslash needing no escape, e.g. `a\\b` is stored as `a\b`.
+func canonicalKey(key string) string {
+	if !strings.Contains(key, `\`) {
+		return key
+	}
+	return ParsePath(key).String()
+}
+
+// Join returns a new path of the path followed by the given one.
+func (p Path) Join(path Path) Path {
+	joined := make(Path, 0, len(p)+len(path))
+	return append(append(joined, p...), path...)
+}
+
+// String returns the dotted form of the path, e.g. "items.0.name".
+// Dots in segments are escaped with a backslash, and so are backslashes which would be read as escapes otherwise,
+// so it can be parsed back by [ParsePath] while other backslashes are kept as is, e.g. `C:\tmp`.
+func (p Path) String() string {
+	sb := new(strings.Builder)
+	for i, segment := range p {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		for j := 0; j < len(segment); j++ {
+			switch c := segment[j]; c {
+			case '.':
+				sb.WriteString(`\.`)
+			case '\\':
+				last := j+1 == len(segment)
+				if (!last && (segment[j+1] == '.' || segment[j+1] == '\\')) || (last && i+1 < len(p)) {
+					sb.WriteByte('\\')
+				}
+				sb.WriteByte(c)
+			default:
+				sb.WriteByte(c)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// Pointer returns the JSON Pointer (RFC 6901) of the path, e.g. "/items/0/name".
+func (p Path) Pointer() string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	sb := new(strings.Builder)
+	for _, segment := range p {
+		sb.WriteByte('/')
+		sb.WriteString(escaper.Replace(segment))
+	}
+	return sb.String()
+}
+
+// Brackets returns the bracket notation of the path used by HTML form field names, e.g. "items[0][name]".
+func (p Path) Brackets() string {
+	if len(p) == 0 {
+		return ""
+	}
+	sb := new(strings.Builder)
+	sb.WriteString(p[0])
+	for _, segment := range p[1:] {
+		sb.WriteByte('[')
+		sb.WriteString(segment)
+		sb.WriteByte(']')
+	}
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+package errpack
+
+import (
+	"github.com/gopi-frame/contract/validation"
+)
+
+// Severity is the severity of an error, only errors of [SeverityError] fail a validation.
+type Severity string
+
+const (
+	// SeverityError is the severity of errors failing the validation, it is the default severity.
+	SeverityError Severity = "error"
+	// SeverityWarning is the severity of acceptable errors worth fixing, e.g. a weak password.
+	SeverityWarning Severity = "warning"
+	// SeverityNotice is the severity of hints, e.g. a URL using http instead of https.
+	SeverityNotice Severity = "notice"
+)
+
+// SeverityOf returns the severity of the error, [SeverityError] if the error has no severity.
+// A bag is of [SeverityError] if it fails, otherwise of [SeverityWarning] if it has warnings, otherwise of [SeverityNotice].
+func SeverityOf(err validation.Error) Severity {
+	switch e := err.(type) {
+	case *Bag:
+		if e.Fails() {
+			return SeverityError
+		}
+		for _, key := range e.keys {
+			for _, err := range e.errors[key] {
+				if SeverityOf(err) == SeverityWarning {
+					return SeverityWarning
+				}
+			}
+		}
+		return SeverityNotice
+	case interface{ Severity() Severity }:
+		if severity := e.Severity(); severity != "" {
+			return severity
+		}
+	}
+	return SeverityError
+}
+
+// WithSeverity returns a copy of the error with the severity, or a copy of a bag whose errors all have the severity.
+// Errors of other types than [Error] and [Bag] are returned as is.
+func WithSeverity(err validation.Error, severity Severity) validation.Error {
+	switch e := err.(type) {
+	case *Bag:
+		return e.mapErrors(func(_ string, err validation.Error) validation.Error {
+			return WithSeverity(err, severity)
+		})
+	case *Error:
+		return e.SetSeverity(severity)
+	case *causeError:
+		return &causeError{err: WithSeverity(e.err, severity), cause: e.cause}
+	}
+	return err
+}
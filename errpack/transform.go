@@ -0,0 +1,152 @@
+package errpack
+
+import (
+	"github.com/gopi-frame/contract/validation"
+)
+
+// Merge returns a new bag of the errors of the bag followed by the errors of the other bag,
+// errors of the same key are kept together in the order of the bag.
+// The interruption error (see [Bag.Err]) of the bag, or else of the other bag, is kept.
+func (e *Bag) Merge(other validation.ErrorBag) *Bag {
+	merged := e.transform(func(path Path, _ validation.Error) (Path, bool) {
+		return path, true
+	})
+	other.Each(func(key string, errs validation.Errors) bool {
+		path := pathOf(other, key)
+		errs.Each(func(_ string, err validation.Error) bool {
+			merged.AddErrorAt(path, err)
+			return true
+		})
+		return true
+	})
+	if b, ok := other.(*Bag); ok && merged.err == nil {
+		merged.err = b.err
+	}
+	return merged
+}
+
+// Prefix returns a new bag whose keys are prefixed by the dotted path, e.g. Prefix("body") turns "name" into "body.name".
+func (e *Bag) Prefix(path string) *Bag {
+	prefix := ParsePath(path)
+	return e.transform(func(p Path, _ validation.Error) (Path, bool) {
+		return prefix.Join(p), true
+	})
+}
+
+// Only returns a new bag of the errors at the given dotted keys or under them, e.g. Only("items") keeps "items" and "items.0.name".
+// A "*" segment matches any segment, e.g. Only("items.*.name").
+func (e *Bag) Only(keys ...string) *Bag {
+	patterns := parsePatterns(keys)
+	return e.transform(func(path Path, _ validation.Error) (Path, bool) {
+		return path, matchAny(patterns, path)
+	})
+}
+
+// Except returns a new bag without the errors at the given dotted keys or under them, the opposite of [Bag.Only].
+func (e *Bag) Except(keys ...string) *Bag {
+	patterns := parsePatterns(keys)
+	return e.transform(func(path Path, _ validation.Error) (Path, bool) {
+		return path, !matchAny(patterns, path)
+	})
+}
+
+// Filter returns a new bag of the errors for which the function returns true.
+func (e *Bag) Filter(f func(key string, err validation.Error) bool) *Bag {
+	return e.transform(func(path Path, err validation.Error) (Path, bool) {
+		return path, f(path.String(), err)
+	})
+}
+
+// RenameKeys returns a new bag whose keys are renamed by the dotted names,
+// the longest name matching the key or a parent of it is replaced,
+// e.g. {"Address": "address", "Address.ZipCode": "address.zip_code"} turns "Address.Street" into "address.Street".
+// Errors whose keys are renamed to the same key are kept together. Messages are not affected.
+func (e *Bag) RenameKeys(names map[string]string) *Bag {
+	type rename struct {
+		from Path
+		to   Path
+	}
+	renames := make([]rename, 0, len(names))
+	for from, to := range names {
+		renames = append(renames, rename{ParsePath(from), ParsePath(to)})
+	}
+	return e.transform(func(path Path, _ validation.Error) (Path, bool) {
+		var longest *rename
+		for i := range renames {
+			if path.hasPrefix(renames[i].from) && (longest == nil || len(renames[i].from) > len(longest.from)) {
+				longest = &renames[i]
+			}
+		}
+		if longest == nil {
+			return path, true
+		}
+		return longest.to.Join(path[len(longest.from):]), true
+	})
+}
+
+// RenameKeysFunc returns a new bag whose dotted keys are renamed by the function, see [Bag.RenameKeys].
+func (e *Bag) RenameKeysFunc(f func(key string) string) *Bag {
+	return e.transform(func(path Path, _ validation.Error) (Path, bool) {
+		return ParsePath(f(path.String())), true
+	})
+}
+
+// transform returns a new bag of the errors kept by the function at the paths it returns.
+func (e *Bag) transform(f func(path Path, err validation.Error) (Path, bool)) *Bag {
+	bag := NewBag()
+	bag.err = e.err
+	for _, key := range e.keys {
+		for _, err := range e.errors[key] {
+			if path, ok := f(e.Path(key), err); ok {
+				bag.AddErrorAt(path, err)
+			}
+		}
+	}
+	return bag
+}
+
+// mapErrors returns a copy of the bag whose errors are replaced by the function, the bag is left untouched.
+func (e *Bag) mapErrors(f func(key string, err validation.Error) validation.Error) *Bag {
+	bag := NewBag()
+	bag.err = e.err
+	for _, key := range e.keys {
+		errs := make([]validation.Error, 0, len(e.errors[key]))
+		for _, err := range e.errors[key] {
+			errs = append(errs, f(key, err))
+		}
+		bag.keys = append(bag.keys, key)
+		bag.paths[key] = e.Path(key)
+		bag.errors[key] = errs
+	}
+	return bag
+}
+
+func parsePatterns(keys []string) []Path {
+	patterns := make([]Path, 0, len(keys))
+	for _, key := range keys {
+		patterns = append(patterns, ParsePath(key))
+	}
+	return patterns
+}
+
+func matchAny(patterns []Path, path Path) bool {
+	for _, pattern := range patterns {
+		if path.hasPrefix(pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefix reports whether the path starts with the segments of the prefix, a "*" segment of the prefix matches any segment.
+func (p Path) hasPrefix(prefix Path) bool {
+	if len(prefix) > len(p) {
+		return false
+	}
+	for i, segment := range prefix {
+		if segment != "*" && segment != p[i] {
+			return false
+		}
+	}
+	return true
+}
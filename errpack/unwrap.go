@@ -0,0 +1,87 @@
+package errpack
+
+import (
+	"encoding/json"
+
+	"github.com/gopi-frame/contract/validation"
+)
+
+// Code is a sentinel matching the errors of the code, e.g.
+//
+//	if errors.Is(err, errpack.Code(code.IsNotBlank)) {
+//		// one of the errors, possibly nested in bags, is of code.IsNotBlank
+//	}
+type Code string
+
+func (c Code) Error() string {
+	return "validation: " + string(c)
+}
+
+// WithCause sets the underlying cause of the error, e.g. the error of parsing the value.
+// Errors of other types than [Error], e.g. built by a custom [validation.ErrorBuilder], are wrapped,
+// the wrapper keeps the code, message and params of the error and unwraps to both the error and the cause.
+// Bags and nil errors are returned as is.
+func WithCause(err validation.Error, cause error) validation.Error {
+	switch e := err.(type) {
+	case nil, *Bag:
+		return err
+	case *Error:
+		return e.SetCause(cause)
+	case *causeError:
+		return &causeError{err: e.err, cause: cause}
+	}
+	return &causeError{err: err, cause: cause}
+}
+
+// causeError is an error of another type than [Error] with a cause, see [WithCause].
+type causeError struct {
+	err   validation.Error
+	cause error
+}
+
+func (e *causeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *causeError) Code() string {
+	return e.err.Code()
+}
+
+func (e *causeError) Message() string {
+	return e.err.Message()
+}
+
+func (e *causeError) SetMessage(message string) validation.Error {
+	return &causeError{err: e.err.SetMessage(message), cause: e.cause}
+}
+
+func (e *causeError) Params() []validation.Param {
+	return e.err.Params()
+}
+
+func (e *causeError) HasParam(key string) bool {
+	return e.err.HasParam(key)
+}
+
+func (e *causeError) AddParam(param validation.Param) validation.Error {
+	return &causeError{err: e.err.AddParam(param), cause: e.cause}
+}
+
+// Severity returns the severity of the wrapped error, see [SeverityOf].
+func (e *causeError) Severity() Severity {
+	return SeverityOf(e.err)
+}
+
+// Cause returns the cause of the error.
+func (e *causeError) Cause() error {
+	return e.cause
+}
+
+// Unwrap returns the wrapped error and the cause.
+func (e *causeError) Unwrap() []error {
+	return []error{e.err, e.cause}
+}
+
+func (e *causeError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.err)
+}
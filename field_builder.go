@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"cmp"
+	"time"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/validator"
+)
+
+// SameAs returns a validator builder that validates the value is equal to the value of the other attribute.
+func SameAs[T comparable](attribute string, value T, otherAttribute string, other T) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsSameAs(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// DifferentFrom returns a validator builder that validates the value is not equal to the value of the other attribute.
+func DifferentFrom[T comparable](attribute string, value T, otherAttribute string, other T) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsDifferentFrom(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// LessThanField returns a validator builder that validates the value is less than the value of the other attribute.
+func LessThanField[T cmp.Ordered](attribute string, value T, otherAttribute string, other T) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsLessThanField(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// LessThanOrEqualToField returns a validator builder that validates the value is less than or equal to the value of the other attribute.
+func LessThanOrEqualToField[T cmp.Ordered](attribute string, value T, otherAttribute string, other T) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsLessThanOrEqualToField(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// GreaterThanField returns a validator builder that validates the value is greater than the value of the other attribute.
+func GreaterThanField[T cmp.Ordered](attribute string, value T, otherAttribute string, other T) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsGreaterThanField(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// GreaterThanOrEqualToField returns a validator builder that validates the value is greater than or equal to the value of the other attribute.
+func GreaterThanOrEqualToField[T cmp.Ordered](attribute string, value T, otherAttribute string, other T) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsGreaterThanOrEqualToField(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// BeforeField returns a validator builder that validates the time is before the time of the other attribute.
+func BeforeField(attribute string, value time.Time, otherAttribute string, other time.Time) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsBeforeField(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
+
+// AfterField returns a validator builder that validates the time is after the time of the other attribute.
+func AfterField(attribute string, value time.Time, otherAttribute string, other time.Time) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsAfterField(otherAttribute, other).SetValue(value)).SetAttribute(attribute)
+}
@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameAs(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), SameAs("password_confirmation", "123456", "password", "123456"))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), SameAs("password_confirmation", "123", "password", "123456"))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "password_confirmation should be the same as password.", validated.GetError("password_confirmation", code.IsSameAs).Error())
+		}
+	})
+
+	t.Run("translated other attribute", func(t *testing.T) {
+		v, err := NewValidator(WithTranslator(&mockTranslator{t: map[string]map[string]string{
+			"en": {
+				"attribute.password":              "Password",
+				"attribute.password_confirmation": "Password confirmation",
+				code.IsSameAs:                     "{{.attribute}} should be the same as {{.other}}.",
+			},
+		}, defaultLanguage: "en"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), SameAs("password_confirmation", "123", "password", "123456"))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "Password confirmation should be the same as Password.", validated.GetError("password_confirmation", code.IsSameAs).Error())
+		}
+	})
+}
+
+func TestDifferentFrom(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), DifferentFrom("new_password", "654321", "password", "123456"))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), DifferentFrom("new_password", "123456", "password", "123456"))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "new_password should be different from password.", validated.GetError("new_password", code.IsDifferentFrom).Error())
+		}
+	})
+}
+
+func TestCompareField(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(),
+		LessThanField("min", 10, "max", 5),
+		LessThanOrEqualToField("min2", 5, "max", 5),
+		GreaterThanField("max", 5, "min", 10),
+		GreaterThanOrEqualToField("max2", 5, "min", 10),
+	)
+	assert.True(t, validated.FailedAt("min", code.IsLessThanField))
+	assert.False(t, validated.HasError("min2"))
+	assert.Equal(t, "max should be greater than min.", validated.GetError("max", code.IsGreaterThanField).Error())
+	assert.True(t, validated.FailedAt("max2", code.IsGreaterThanOrEqualToField))
+}
+
+func TestTimeField(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			AfterField("end_date", start.Add(time.Hour), "start_date", start),
+			BeforeField("start_date", start, "end_date", start.Add(time.Hour)),
+		)
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			AfterField("end_date", start, "start_date", start),
+			BeforeField("start_date", start, "end_date", start.Add(-time.Hour)),
+		)
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "end_date should be after start_date.", validated.GetError("end_date", code.IsAfterField).Error())
+			assert.Equal(t, "start_date should be before end_date.", validated.GetError("start_date", code.IsBeforeField).Error())
+		}
+	})
+}
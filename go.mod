@@ -1,3 +1,9 @@
 module github.com/gopi-frame/validation
 
 go 1.22
+
+require (
+	github.com/google/uuid v1.6.0
+	github.com/pelletier/go-toml/v2 v2.2.2
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -10,3 +10,10 @@ import (
 func Group[T any](attribute string, value T, rules ...validation.Rule[T]) validation.ValidatorBuilder {
 	return NewBuilder(validator.Group(rules...).SetValue(value)).SetAttribute(attribute)
 }
+
+// Bail returns a validator builder that validates the given value using the given rules,
+// and stops at the first error.
+// If the value is an implementation of the [validation.Validatable] interface, it will be validated first before the rules.
+func Bail[T any](attribute string, value T, rules ...validation.Rule[T]) validation.ValidatorBuilder {
+	return NewBuilder(validator.Group(rules...).SetValue(value)).SetAttribute(attribute).(*Builder).SetBail(true)
+}
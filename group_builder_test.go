@@ -88,3 +88,91 @@ func TestGroup_ValidatableImpl(t *testing.T) {
 		}
 	})
 }
+
+func TestGroup_SameCode(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(), Group(
+		"password",
+		"abcdef",
+		validator.IsMatch("[0-9]"),
+		validator.IsMatch("[A-Z]"),
+	))
+	if assert.True(t, validated.Fails()) {
+		errs := validated.GetErrors("password")
+		assert.Len(t, errs, 2)
+		assert.True(t, errs.Has(code.IsMatch))
+		assert.Equal(t, "password should match \"[0-9]\".", errs.Get(code.IsMatch).Error())
+		assert.Equal(t, []string{
+			"password should match \"[0-9]\".",
+			"password should match \"[A-Z]\".",
+		}, validated.GetMessage("password"))
+		customized := validated.SetMessages(map[string]map[string]string{
+			"password": {code.IsMatch: "{{.attribute}} is too weak"},
+		})
+		assert.Equal(t, []string{"password is too weak", "password is too weak"}, customized.GetMessage("password"))
+	}
+}
+
+func TestBail(t *testing.T) {
+	t.Run("builder", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			Bail("password", "", validator.IsNotBlank[string](), validator.IsMinLength(6), validator.IsMatch("[0-9]")),
+			MinLength("password", "", 8),
+			Group("username", "", validator.IsNotBlank[string](), validator.IsMinLength(3)),
+		)
+		if assert.True(t, validated.Fails()) {
+			assert.Len(t, validated.GetErrors("password"), 1)
+			assert.True(t, validated.FailedAt("password", code.IsNotBlank))
+			assert.Len(t, validated.GetErrors("username"), 2)
+		}
+	})
+
+	t.Run("rule", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			Group("password", "", validator.Bail(validator.IsNotBlank[string](), validator.IsMinLength(6)), validator.IsMatch("[0-9]")),
+		)
+		if assert.True(t, validated.Fails()) {
+			assert.Len(t, validated.GetErrors("password"), 2)
+			assert.True(t, validated.FailedAt("password", code.IsNotBlank))
+			assert.True(t, validated.FailedAt("password", code.IsMatch))
+		}
+	})
+
+	t.Run("validator", func(t *testing.T) {
+		v, err := NewValidator(WithBail())
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			Group("password", "", validator.IsNotBlank[string](), validator.IsMinLength(6)),
+			MinLength("password", "", 8),
+			Group("username", "", validator.IsNotBlank[string](), validator.IsMinLength(3)),
+		)
+		if assert.True(t, validated.Fails()) {
+			assert.Len(t, validated.GetErrors("password"), 1)
+			assert.Len(t, validated.GetErrors("username"), 1)
+		}
+	})
+
+	t.Run("pass", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			Bail("password", "123456", validator.IsNotBlank[string](), validator.IsMinLength(6)),
+		)
+		assert.False(t, validated.Fails())
+	})
+}
@@ -1,6 +1,7 @@
 package is
 
 import (
+	"net/mail"
 	"net/netip"
 	"net/url"
 )
@@ -39,3 +40,8 @@ func URLQuery(s string) bool {
 	_, err := url.ParseQuery(s)
 	return err == nil
 }
+
+func Email(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
@@ -0,0 +1,17 @@
+package is
+
+import "reflect"
+
+// Blank reports whether the value is nil, an empty slice or map, or the zero value of its type,
+// so that a comparable value is blank when it equals its zero value like the not_blank rule checks.
+func Blank(value any) bool {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
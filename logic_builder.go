@@ -0,0 +1,27 @@
+package validation
+
+import (
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/validator"
+)
+
+// AnyOf returns a validator builder that validates the given value passes at least one of the given rules.
+func AnyOf[T any](attribute string, value T, rules ...validation.Rule[T]) validation.ValidatorBuilder {
+	return NewBuilder(validator.AnyOf(rules...).SetValue(value)).SetAttribute(attribute)
+}
+
+// AllOf returns a validator builder that validates the given value passes all the given rules,
+// the failures are reported as a single error.
+func AllOf[T any](attribute string, value T, rules ...validation.Rule[T]) validation.ValidatorBuilder {
+	return NewBuilder(validator.AllOf(rules...).SetValue(value)).SetAttribute(attribute)
+}
+
+// OneOf returns a validator builder that validates the given value passes exactly one of the given rules.
+func OneOf[T any](attribute string, value T, rules ...validation.Rule[T]) validation.ValidatorBuilder {
+	return NewBuilder(validator.OneOf(rules...).SetValue(value)).SetAttribute(attribute)
+}
+
+// Not returns a validator builder that validates the given value does not pass the given rule.
+func Not[T any](attribute string, value T, rule validation.Rule[T], code string, message string) validation.ValidatorBuilder {
+	return NewBuilder(validator.Not(rule, code, message).SetValue(value)).SetAttribute(attribute)
+}
@@ -0,0 +1,172 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnyOf(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), AnyOf("host", "127.0.0.1", validator.IsIP4(), validator.IsURL()))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), AnyOf("host", "::1", validator.IsIP4(), validator.IsURLWithScheme("https")))
+		if assert.True(t, validated.Fails()) {
+			err := validated.GetError("host", code.IsAnyOf)
+			if assert.NotNil(t, err) {
+				assert.Equal(t, "host should satisfy at least one of the following: "+
+					"host should be a valid IPv4 address.; host should be a valid URL with scheme https.", err.Error())
+				for _, param := range err.Params() {
+					if nested, ok := param.(*errpack.ErrorsParam); ok {
+						assert.Len(t, nested.Errors(), 2)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("custom message", func(t *testing.T) {
+		v, err := NewValidator(WithMessages(map[string]string{
+			code.IsAnyOf: "{{.attribute}} must be an IPv4 address or a hostname",
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), AnyOf("host", "::1", validator.IsIP4(), validator.IsMatch("^[a-z.]+$")))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "host must be an IPv4 address or a hostname", validated.GetError("host", code.IsAnyOf).Error())
+		}
+	})
+}
+
+func TestAllOf(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), AllOf("code", "ABC", validator.IsUpper(), validator.IsLength(3)))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), AllOf("code", "abcd", validator.IsUpper(), validator.IsLength(3)))
+		if assert.True(t, validated.Fails()) {
+			assert.Len(t, validated.GetErrors("code"), 1)
+			assert.Equal(t, "code should satisfy all of the following: "+
+				"code should be uppercase.; code should have length 3.", validated.GetError("code", code.IsAllOf).Error())
+		}
+	})
+}
+
+func TestOneOf(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), OneOf("value", "abc", validator.IsUpper(), validator.IsLower()))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("none passed", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), OneOf("value", "aBc", validator.IsUpper(), validator.IsLower()))
+		assert.True(t, validated.FailedAt("value", code.IsOneOf))
+	})
+
+	t.Run("more than one passed", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), OneOf("value", "abc", validator.IsLower(), validator.IsAlpha()))
+		if assert.True(t, validated.FailedAt("value", code.IsOneOf)) {
+			assert.Equal(t, "value should satisfy exactly one of the rules.", validated.GetError("value", code.IsOneOf).Error())
+			assert.Equal(t, 2, errpack.ParamValues(validated.GetError("value", code.IsOneOf))["passed"])
+		}
+	})
+}
+
+func TestNot(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), Not("host", "example.com", validator.IsIP(), "is_not_ip", "{{.attribute}} should not be an IP address."))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), Not("host", "127.0.0.1", validator.IsIP(), "is_not_ip", "{{.attribute}} should not be an IP address."))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "host should not be an IP address.", validated.GetError("host", "is_not_ip").Error())
+		}
+	})
+}
+
+func TestLogic_Severity(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	weak := validator.Warn(validator.IsMinLength(10))
+
+	t.Run("any of", func(t *testing.T) {
+		validated := v.Validate(context.Background(), AnyOf("password", "secret", validator.IsUpper(), weak))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("all of", func(t *testing.T) {
+		validated := v.Validate(context.Background(), AllOf("password", "secret", validator.IsLower(), weak))
+		assert.False(t, validated.Fails())
+
+		validated = v.Validate(context.Background(), AllOf("password", "secret", validator.IsUpper(), weak))
+		if assert.True(t, validated.FailedAt("password", code.IsAllOf)) {
+			assert.Equal(t, "password should satisfy all of the following: password should be uppercase.",
+				validated.GetError("password", code.IsAllOf).Error())
+		}
+	})
+
+	t.Run("one of", func(t *testing.T) {
+		validated := v.Validate(context.Background(), OneOf("password", "secret", validator.IsLower(), weak))
+		if assert.True(t, validated.FailedAt("password", code.IsOneOf)) {
+			assert.Equal(t, 2, errpack.ParamValues(validated.GetError("password", code.IsOneOf))["passed"])
+		}
+
+		validated = v.Validate(context.Background(), OneOf("password", "secret", validator.IsUpper(), weak))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("not", func(t *testing.T) {
+		validated := v.Validate(context.Background(), Not("password", "secret", weak, "is_not_weak", "{{.attribute}} should not be weak."))
+		assert.True(t, validated.FailedAt("password", "is_not_weak"))
+	})
+}
@@ -3,8 +3,8 @@ package message
 const (
 	IsBlank                = "{{.attribute}} should be blank."
 	IsNotBlank             = "{{.attribute}} should not be blank."
-	IsIn                   = "{{.attribute}} should be one of {{.values}}."
-	IsNotIn                = "{{.attribute}} should not be one of {{.values}}."
+	IsIn                   = "{{.attribute}} should be one of {{quote .values | join \", \"}}."
+	IsNotIn                = "{{.attribute}} should not be one of {{quote .values | join \", \"}}."
 	IsEqualTo              = "{{.attribute}} should be equal to {{.value}}."
 	IsNotEqualTo           = "{{.attribute}} should not be equal to {{.value}}."
 	IsLessThan             = "{{.attribute}} should be less than {{.value}}."
@@ -17,18 +17,18 @@ const (
 	IsLength           = "{{.attribute}} should have length {{.length}}."
 	IsMinLength        = "{{.attribute}} should have length greater than or equal to {{.min}}."
 	IsMaxLength        = "{{.attribute}} should have length less than or equal to {{.max}}."
-	IsStartsWith       = "{{.attribute}} should start with {{.prefix}}."
-	IsStartsWithAny    = "{{.attribute}} should start with one of {{.prefixes}}."
-	IsNotStartsWith    = "{{.attribute}} should not start with {{.prefix}}."
-	IsNotStartsWithAny = "{{.attribute}} should not start with any of {{.prefixes}}."
-	IsEndsWith         = "{{.attribute}} should end with {{.suffix}}."
-	IsEndsWithAny      = "{{.attribute}} should end with one of {{.suffixes}}."
-	IsNotEndsWith      = "{{.attribute}} should not end with {{.suffix}}."
-	IsNotEndsWithAny   = "{{.attribute}} should not end with any of {{.suffixes}}."
-	IsMatch            = "{{.attribute}} should match {{.pattern}}."
-	IsNotMatch         = "{{.attribute}} should not match {{.pattern}}."
-	IsContains         = "{{.attribute}} should contain {{.substring}}."
-	IsNotContains      = "{{.attribute}} should not contain {{.substring}}."
+	IsStartsWith       = "{{.attribute}} should start with {{quote .prefix}}."
+	IsStartsWithAny    = "{{.attribute}} should start with one of {{quote .prefixes | join \", \"}}."
+	IsNotStartsWith    = "{{.attribute}} should not start with {{quote .prefix}}."
+	IsNotStartsWithAny = "{{.attribute}} should not start with any of {{quote .prefixes | join \", \"}}."
+	IsEndsWith         = "{{.attribute}} should end with {{quote .suffix}}."
+	IsEndsWithAny      = "{{.attribute}} should end with one of {{quote .suffixes | join \", \"}}."
+	IsNotEndsWith      = "{{.attribute}} should not end with {{quote .suffix}}."
+	IsNotEndsWithAny   = "{{.attribute}} should not end with any of {{quote .suffixes | join \", \"}}."
+	IsMatch            = "{{.attribute}} should match {{quote .pattern}}."
+	IsNotMatch         = "{{.attribute}} should not match {{quote .pattern}}."
+	IsContains         = "{{.attribute}} should contain {{quote .substring}}."
+	IsNotContains      = "{{.attribute}} should not contain {{quote .substring}}."
 	IsUpper            = "{{.attribute}} should be uppercase."
 	IsLower            = "{{.attribute}} should be lowercase."
 	IsAlpha            = "{{.attribute}} should only contain letter."
@@ -50,31 +50,41 @@ const (
 )
 
 const (
-	IsIncludes = "{{.attribute}} should include {{.values}}."
-	IsExcludes = "{{.attribute}} should exclude {{.values}}."
+	IsIncludes = "{{.attribute}} should include {{quote .values | join \", \"}}."
+	IsExcludes = "{{.attribute}} should exclude {{quote .values | join \", \"}}."
 	IsUnique   = "{{.attribute}} should not contain duplicate elements."
 	IsCount    = "{{.attribute}} should contain {{.count}} element(s)."
 	IsMinCount = "{{.attribute}} should contain at least {{.count}} element(s)."
 	IsMaxCount = "{{.attribute}} should contain at most {{.count}} element(s)."
 )
 
+// plural forms of the slice type messages
 const (
-	IsContainsKey    = "{{.attribute}} should contain key {{.key}}."
-	IsNotContainsKey = "{{.attribute}} should not contain key {{.key}}."
+	IsCountOne      = "{{.attribute}} should contain {{.count}} element."
+	IsCountOther    = "{{.attribute}} should contain {{.count}} elements."
+	IsMinCountOne   = "{{.attribute}} should contain at least {{.count}} element."
+	IsMinCountOther = "{{.attribute}} should contain at least {{.count}} elements."
+	IsMaxCountOne   = "{{.attribute}} should contain at most {{.count}} element."
+	IsMaxCountOther = "{{.attribute}} should contain at most {{.count}} elements."
 )
 
 const (
-	IsTime              = "{{.attribute}} should be a valid time in format {{.layout}}."
+	IsContainsKey    = "{{.attribute}} should contain key {{quote .key}}."
+	IsNotContainsKey = "{{.attribute}} should not contain key {{quote .key}}."
+)
+
+const (
+	IsTime              = "{{.attribute}} should be a valid time in format {{quote .layout}}."
 	IsDuration          = "{{.attribute}} should be a valid duration."
 	IsTimezone          = "{{.attribute}} should be a valid timezone."
-	IsBefore            = "{{.attribute}} should be before {{.time}}."
-	IsBeforeOrEqualTo   = "{{.attribute}} should be before or equal to {{.time}}."
-	IsAfter             = "{{.attribute}} should be after {{.time}}."
-	IsAfterOrEqualTo    = "{{.attribute}} should be after or equal to {{.time}}."
-	IsBeforeTZ          = "{{.attribute}} in timezone {{.timezone}} should be before {{.time}}."
-	IsAfterTZ           = "{{.attribute}} in timezone {{.timezone}} should be after {{.time}}."
-	IsBeforeOrEqualToTZ = "{{.attribute}} in timezone {{.timezone}} should be before or equal to {{.time}}."
-	IsAfterOrEqualToTZ  = "{{.attribute}} in timezone {{.timezone}} should be after or equal to {{.time}}."
+	IsBefore            = "{{.attribute}} should be before {{date .layout .time | quote}}."
+	IsBeforeOrEqualTo   = "{{.attribute}} should be before or equal to {{date .layout .time | quote}}."
+	IsAfter             = "{{.attribute}} should be after {{date .layout .time | quote}}."
+	IsAfterOrEqualTo    = "{{.attribute}} should be after or equal to {{date .layout .time | quote}}."
+	IsBeforeTZ          = "{{.attribute}} in timezone {{quote .timezone}} should be before {{date .layout .time | quote}}."
+	IsAfterTZ           = "{{.attribute}} in timezone {{quote .timezone}} should be after {{date .layout .time | quote}}."
+	IsBeforeOrEqualToTZ = "{{.attribute}} in timezone {{quote .timezone}} should be before or equal to {{date .layout .time | quote}}."
+	IsAfterOrEqualToTZ  = "{{.attribute}} in timezone {{quote .timezone}} should be after or equal to {{date .layout .time | quote}}."
 )
 
 const (
@@ -101,6 +111,7 @@ const (
 	IsURLWithScheme = "{{.attribute}} should be a valid URL with scheme {{.scheme}}."
 	IsRequestURI    = "{{.attribute}} should be a valid request URI."
 	IsURLQuery      = "{{.attribute}} should be a valid URL query string."
+	IsEmail         = "{{.attribute}} should be a valid email address."
 )
 
 const (
@@ -117,3 +128,29 @@ const (
 	IsPathAbsolute  = "{{.attribute}} should be an absolute path."
 	IsPathRelative  = "{{.attribute}} should be a relative path."
 )
+
+const (
+	IsAnyOf = "{{.attribute}} should satisfy at least one of the following: {{.errors}}"
+	IsAllOf = "{{.attribute}} should satisfy all of the following: {{.errors}}"
+	IsOneOf = "{{.attribute}} should satisfy exactly one of the rules."
+)
+
+const (
+	IsSameAs                    = "{{.attribute}} should be the same as {{.other}}."
+	IsDifferentFrom             = "{{.attribute}} should be different from {{.other}}."
+	IsLessThanField             = "{{.attribute}} should be less than {{.other}}."
+	IsLessThanOrEqualToField    = "{{.attribute}} should be less than or equal to {{.other}}."
+	IsGreaterThanField          = "{{.attribute}} should be greater than {{.other}}."
+	IsGreaterThanOrEqualToField = "{{.attribute}} should be greater than or equal to {{.other}}."
+	IsBeforeField               = "{{.attribute}} should be before {{.other}}."
+	IsAfterField                = "{{.attribute}} should be after {{.other}}."
+)
+
+const (
+	IsRequiredIf      = "{{.attribute}} is required when {{.other}} is {{quote .values | join \", \"}}."
+	IsRequiredUnless  = "{{.attribute}} is required unless {{.other}} is {{quote .values | join \", \"}}."
+	IsRequiredWith    = "{{.attribute}} is required when any of {{join \", \" .others}} is present."
+	IsRequiredWithAll = "{{.attribute}} is required when all of {{join \", \" .others}} are present."
+	IsRequiredWithout = "{{.attribute}} is required when any of {{join \", \" .others}} is not present."
+	IsProhibitedIf    = "{{.attribute}} is prohibited when {{.other}} is {{quote .values | join \", \"}}."
+)
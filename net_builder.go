@@ -32,3 +32,7 @@ func RequestURI(attribute string, value string) validation.ValidatorBuilder {
 func URLQuery(attribute string, value string) validation.ValidatorBuilder {
 	return NewBuilder(validator.IsURLQuery().SetValue(value)).SetAttribute(attribute)
 }
+
+func Email(attribute string, value string) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsEmail().SetValue(value)).SetAttribute(attribute)
+}
@@ -155,3 +155,28 @@ func TestURLQuery(t *testing.T) {
 		}
 	})
 }
+
+func TestEmail(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), Email("client.email", "gopher@example.com"))
+		assert.False(t, validated.Fails())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			Email("client.email", "Gopher <gopher@example.com>"),
+			Email("client.backup", "gopher@"),
+		)
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "client.email should be a valid email address.", validated.GetError("client.email", code.IsEmail).Error())
+			assert.True(t, validated.FailedAt("client.backup", code.IsEmail))
+		}
+	})
+}
@@ -1,6 +1,11 @@
 package validation
 
-import "github.com/gopi-frame/contract/validation"
+import (
+	"errors"
+	"text/template"
+
+	"github.com/gopi-frame/contract/validation"
+)
 
 type Option func(v *Validator) error
 
@@ -25,9 +30,81 @@ func WithErrorBuilder(builder validation.ErrorBuilder) Option {
 	}
 }
 
+// WithMessages sets custom messages by error code,
+// [NewValidator] returns an error if any of the messages is malformed.
 func WithMessages(messages map[string]string) Option {
 	return func(v *Validator) error {
 		v.messages = messages
 		return nil
 	}
 }
+
+// WithCustomMessages sets custom messages by attribute key and error code, e.g. {"items.*.name": {code.IsNotBlank: "Every item needs a name."}},
+// a "*" segment of a key matches any segment, and keys without wildcards take precedence.
+// They take precedence over the messages set by [WithMessages], then come the translator and the default messages.
+// If languages are given, the messages only apply to validations in those languages or their sub languages, e.g. "zh" applies to "zh-CN",
+// and take precedence over the messages set without languages. The option can be given several times, e.g. once per language.
+// [NewValidator] returns an error if any of the messages is malformed.
+func WithCustomMessages(messages map[string]map[string]string, languages ...string) Option {
+	return func(v *Validator) error {
+		if len(languages) == 0 {
+			v.addCustomMessages("", messages)
+		}
+		for _, language := range languages {
+			v.addCustomMessages(language, messages)
+		}
+		return nil
+	}
+}
+
+// WithBail makes the validator stop validating an attribute after its first error.
+func WithBail() Option {
+	return func(v *Validator) error {
+		v.bail = true
+		return nil
+	}
+}
+
+// WithConcurrency makes the validator validate up to n attributes concurrently.
+// Validators of the same attribute still run in order.
+func WithConcurrency(n int) Option {
+	return func(v *Validator) error {
+		if n < 1 {
+			return errors.New("concurrency should be greater than 0")
+		}
+		v.concurrency = n
+		return nil
+	}
+}
+
+// WithAttributeNames sets the display names of attributes in the default language, e.g. {"email_address": "E-mail address"}.
+// They are used when the translator has no "attribute.<name>" entry for the attribute,
+// validations in other languages only use the translator, see [WithDefaultLanguage] and [BindLanguage].
+// See [BindAttributeNames] to override them for a single validation.
+func WithAttributeNames(names map[string]string) Option {
+	return func(v *Validator) error {
+		v.attributeNames = names
+		return nil
+	}
+}
+
+// WithErrorHandler sets the handler receiving the errors of rendering error messages,
+// e.g. a malformed message set by [validation.ErrorBag.SetMessages].
+// Rendering never panics, it falls back to the translated message and then the default message.
+// See translator.SetErrorHandler for the errors of executing translations.
+func WithErrorHandler(handler func(err error)) Option {
+	return func(v *Validator) error {
+		v.errorHandler = handler
+		return nil
+	}
+}
+
+// WithFuncs adds functions for rendering custom and default messages, besides the ones of errpack.Funcs,
+// e.g. WithFuncs(template.FuncMap{"money": formatMoney}).
+// Functions used by translations should be registered by translator.RegisterFuncs instead.
+func WithFuncs(funcs template.FuncMap) Option {
+	return func(v *Validator) error {
+		v.funcs = funcs
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/validator"
+)
+
+// RequiredIf returns a validator builder that validates the value is not blank
+// when the value of the other attribute is one of the given values.
+func RequiredIf[T comparable, O comparable](attribute string, value T, otherAttribute string, other O, values ...O) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsRequiredIf[T](otherAttribute, other, values...).SetValue(value)).SetAttribute(attribute)
+}
+
+// RequiredUnless returns a validator builder that validates the value is not blank
+// unless the value of the other attribute is one of the given values.
+func RequiredUnless[T comparable, O comparable](attribute string, value T, otherAttribute string, other O, values ...O) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsRequiredUnless[T](otherAttribute, other, values...).SetValue(value)).SetAttribute(attribute)
+}
+
+// RequiredWith returns a validator builder that validates the value is not blank when any of the other fields is not blank.
+func RequiredWith[T any](attribute string, value T, others ...validator.Field) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsRequiredWith[T](others...).SetValue(value)).SetAttribute(attribute)
+}
+
+// RequiredWithAll returns a validator builder that validates the value is not blank when all the other fields are not blank.
+func RequiredWithAll[T any](attribute string, value T, others ...validator.Field) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsRequiredWithAll[T](others...).SetValue(value)).SetAttribute(attribute)
+}
+
+// RequiredWithout returns a validator builder that validates the value is not blank when any of the other fields is blank.
+func RequiredWithout[T any](attribute string, value T, others ...validator.Field) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsRequiredWithout[T](others...).SetValue(value)).SetAttribute(attribute)
+}
+
+// ProhibitedIf returns a validator builder that validates the value is blank
+// when the value of the other attribute is one of the given values.
+func ProhibitedIf[T comparable, O comparable](attribute string, value T, otherAttribute string, other O, values ...O) validation.ValidatorBuilder {
+	return NewBuilder(validator.IsProhibitedIf[T](otherAttribute, other, values...).SetValue(value)).SetAttribute(attribute)
+}
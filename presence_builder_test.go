@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredIf(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(),
+		RequiredIf("state", "", "country", "US", "US", "CA"),
+		RequiredIf("province", "", "country", "US", "CN"),
+		RequiredIf("zip", "12345", "country", "US", "US"),
+	)
+	if assert.True(t, validated.Fails()) {
+		assert.Equal(t, "state is required when country is \"US\", \"CA\".", validated.GetError("state", code.IsRequiredIf).Error())
+		assert.Equal(t, []string{"US", "CA"}, errpack.ParamValues(validated.GetError("state", code.IsRequiredIf))["values"])
+		assert.False(t, validated.HasError("province"))
+		assert.False(t, validated.HasError("zip"))
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(),
+		RequiredUnless("state", "", "country", "CN", "US"),
+		RequiredUnless("province", "", "country", "US", "US"),
+	)
+	if assert.True(t, validated.Fails()) {
+		assert.Equal(t, "state is required unless country is \"US\".", validated.GetError("state", code.IsRequiredUnless).Error())
+		assert.False(t, validated.HasError("province"))
+	}
+}
+
+func TestRequiredWith(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(),
+		RequiredWith("name", "", validator.NewField("email", ""), validator.NewField("phone", "123")),
+		RequiredWith("nickname", "", validator.NewField("email", ""), validator.NewField("tags", []string{})),
+	)
+	if assert.True(t, validated.Fails()) {
+		assert.Equal(t, "name is required when any of email, phone is present.", validated.GetError("name", code.IsRequiredWith).Error())
+		assert.False(t, validated.HasError("nickname"))
+	}
+
+	t.Run("attribute names", func(t *testing.T) {
+		v, err := NewValidator(WithAttributeNames(map[string]string{"email": "E-mail", "phone": "Phone number"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(),
+			RequiredWith("name", "", validator.NewField("email", ""), validator.NewField("phone", "123")),
+		)
+		assert.Equal(t, "name is required when any of E-mail, Phone number is present.", validated.GetError("name", code.IsRequiredWith).Error())
+		assert.Equal(t, []string{"email", "phone"}, errpack.ParamValues(validated.GetError("name", code.IsRequiredWith))["others"])
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		validated := v.Validate(context.Background(),
+			RequiredWith("tags", []string{}, validator.NewField("title", "post")),
+			RequiredWith("labels", []string{"go"}, validator.NewField("title", "post")),
+		)
+		assert.True(t, validated.FailedAt("tags", code.IsRequiredWith))
+		assert.False(t, validated.HasError("labels"))
+	})
+
+	t.Run("blank like not blank", func(t *testing.T) {
+		empty := ""
+		validated := v.Validate(context.Background(),
+			RequiredWith("point", [2]int{}, validator.NewField("title", "post")),
+			RequiredWith("owner", "", validator.NewField("reviewer", &empty)),
+			RequiredWith("zone", "", validator.NewField("offset", [2]int{})),
+		)
+		assert.Equal(t, []string{"point", "owner"}, validated.Failed())
+		assert.True(t, Validate(context.Background(), NotBlank("point", [2]int{})).Fails())
+		assert.False(t, Validate(context.Background(), NotBlank("reviewer", &empty)).Fails())
+	})
+}
+
+func TestRequiredWithAll(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(),
+		RequiredWithAll("name", "", validator.NewField("email", "a@b.c"), validator.NewField("phone", "123")),
+		RequiredWithAll("nickname", "", validator.NewField("email", "a@b.c"), validator.NewField("phone", "")),
+	)
+	if assert.True(t, validated.Fails()) {
+		assert.True(t, validated.FailedAt("name", code.IsRequiredWithAll))
+		assert.False(t, validated.HasError("nickname"))
+	}
+}
+
+func TestRequiredWithout(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var phone *string
+	validated := v.Validate(context.Background(),
+		RequiredWithout("email", "", validator.NewField("phone", phone)),
+		RequiredWithout("username", "", validator.NewField("email", "a@b.c")),
+	)
+	if assert.True(t, validated.Fails()) {
+		assert.Equal(t, "email is required when any of phone is not present.", validated.GetError("email", code.IsRequiredWithout).Error())
+		assert.False(t, validated.HasError("username"))
+	}
+}
+
+func TestProhibitedIf(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated := v.Validate(context.Background(),
+		ProhibitedIf("discount", 10, "type", "wholesale", "wholesale"),
+		ProhibitedIf("coupon", "ABC", "type", "retail", "wholesale"),
+	)
+	if assert.True(t, validated.Fails()) {
+		assert.Equal(t, "discount is prohibited when type is \"wholesale\".", validated.GetError("discount", code.IsProhibitedIf).Error())
+		assert.False(t, validated.HasError("coupon"))
+	}
+}
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/gopi-frame/contract/validation"
+	error2 "github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/validator"
+)
+
+// Warn returns a validator builder that reports the errors of the given builders as warnings,
+// which are collected in the bag but don't fail the validation, e.g. a weak but acceptable password.
+// See [error2.Bag.Warnings].
+func Warn(builders ...validation.ValidatorBuilder) validation.ValidatorBuilder {
+	return withSeverity(error2.SeverityWarning, builders)
+}
+
+// Notice returns a validator builder that reports the errors of the given builders as notices,
+// which are collected in the bag but don't fail the validation, e.g. a URL using http instead of https.
+func Notice(builders ...validation.ValidatorBuilder) validation.ValidatorBuilder {
+	return withSeverity(error2.SeverityNotice, builders)
+}
+
+func withSeverity(severity error2.Severity, builders []validation.ValidatorBuilder) validation.ValidatorBuilder {
+	return &wrapperBuilder{
+		builders: builders,
+		wrap: func() func(v validation.Validatable) validation.Validatable {
+			return func(v validation.Validatable) validation.Validatable {
+				// warnings never bail, so the wrapper is not a bailValidatable.
+				return validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+					if err := v.Validate(ctx, builder); err != nil {
+						return error2.WithSeverity(err, severity)
+					}
+					return nil
+				})
+			}
+		},
+	}
+}
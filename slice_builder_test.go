@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/translator"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -97,7 +98,7 @@ func TestCount(t *testing.T) {
 		}
 		validated := v.Validate(context.Background(), Count("elements", elements, 2))
 		if assert.True(t, validated.Fails()) {
-			assert.Equal(t, "elements should contain 2 element(s).", validated.GetError("elements", code.IsCount).Error())
+			assert.Equal(t, "elements should contain 2 elements.", validated.GetError("elements", code.IsCount).Error())
 		}
 	})
 }
@@ -120,7 +121,7 @@ func TestMinCount(t *testing.T) {
 		}
 		validated := v.Validate(context.Background(), MinCount("elements", elements, 4))
 		if assert.True(t, validated.Fails()) {
-			assert.Equal(t, "elements should contain at least 4 element(s).", validated.GetError("elements", code.IsMinCount).Error())
+			assert.Equal(t, "elements should contain at least 4 elements.", validated.GetError("elements", code.IsMinCount).Error())
 		}
 	})
 }
@@ -143,7 +144,37 @@ func TestMaxCount(t *testing.T) {
 		}
 		validated := v.Validate(context.Background(), MaxCount("elements", elements, 2))
 		if assert.True(t, validated.Fails()) {
-			assert.Equal(t, "elements should contain at most 2 element(s).", validated.GetError("elements", code.IsMaxCount).Error())
+			assert.Equal(t, "elements should contain at most 2 elements.", validated.GetError("elements", code.IsMaxCount).Error())
+		}
+	})
+	t.Run("singular", func(t *testing.T) {
+		var elements = []string{"a", "b", "c"}
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), MaxCount("elements", elements, 1))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "elements should contain at most 1 element.", validated.GetError("elements", code.IsMaxCount).Error())
+		}
+	})
+	t.Run("plural forms of locale", func(t *testing.T) {
+		if err := translator.LoadBuiltin("ru"); err != nil {
+			t.Fatal(err)
+		}
+		var elements = []string{"a", "b", "c"}
+		v, err := NewValidator(WithDefaultLanguage("ru"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for count, want := range map[int]string{
+			1: "elements должно содержать не более 1 элемента.",
+			2: "elements должно содержать не более 2 элементов.",
+		} {
+			validated := v.Validate(context.Background(), MaxCount("elements", elements, count))
+			if assert.True(t, validated.Fails()) {
+				assert.Equal(t, want, validated.GetError("elements", code.IsMaxCount).Error())
+			}
 		}
 	})
 }
@@ -0,0 +1,651 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/is"
+	"github.com/gopi-frame/validation/message"
+	"github.com/gopi-frame/validation/validator"
+)
+
+// TagName is the struct tag read by [Validator.ValidateStruct].
+const TagName = "validate"
+
+// tagRule builds a validatable for the given tag parameter and field value.
+type tagRule func(param string, value reflect.Value) (validation.Validatable, error)
+
+var tagRules = new(sync.Map)
+
+// RegisterTag registers a struct tag rule.
+// The factory receives the tag parameter (the part after "="), and the returned rule is applied to
+// every field whose type is T or has the same underlying kind as T.
+// Registering an existing name replaces the previous rule, including the built-in ones.
+func RegisterTag[T any](name string, factory func(param string) (validation.Rule[T], error)) {
+	typ := reflect.TypeFor[T]()
+	tagRules.Store(name, tagRule(func(param string, value reflect.Value) (validation.Validatable, error) {
+		if value.Type() != typ {
+			if typ.Kind() == reflect.Interface && value.Type().Implements(typ) {
+				value = value.Convert(typ)
+			} else if value.Kind() == typ.Kind() && value.Type().ConvertibleTo(typ) {
+				value = value.Convert(typ)
+			} else {
+				return nil, fmt.Errorf("validation: tag %q does not support type %s", name, value.Type())
+			}
+		}
+		rule, err := factory(param)
+		if err != nil {
+			return nil, err
+		}
+		return validator.RuleFunc[T](rule.Validate).SetValue(value.Interface().(T)), nil
+	}))
+}
+
+// ValidateStruct validates the given struct by its "validate" tags.
+//
+// Tags are comma separated rule names with an optional parameter, e.g. `validate:"required,min_length=3"`.
+// The key of a field is the name in its json tag, or the field name if there is none.
+// Nested structs, slices and maps are validated recursively,
+// so the errors are stored under keys like "address.zip" and "items.3.sku".
+// A field that implements [validation.Validatable] is validated by itself as well.
+// Exported embedded structs without a json name are flattened like encoding/json does, unexported ones are skipped,
+// and a value referencing itself, e.g. n.Next = n, is only validated once.
+//
+// Built-in rule names are the [code] constants without the "is_" prefix for the rules whose parameter fits in a tag,
+// e.g. "not_blank", "min_length", "greater_than", "email", "uuid", plus the following aliases and flags:
+//   - required: the value should not be blank, an empty slice or map or a nil pointer
+//   - omitempty: skip the remaining rules when the value is blank
+//   - bail: stop at the first error of the field
+//   - eq, ne, lt, lte, gt, gte: aliases of equal, not_equal, less_than, less_than_or_equal_to, greater_than and greater_than_or_equal_to
+//   - in, not_in: the value should (not) be one of the space separated parameters
+//   - ip4, ip6: aliases of ipv4 and ipv6
+//
+// Rules taking typed or several parameters have no tag, e.g. includes, excludes, contains_key, not_contains_key,
+// before, after, enum and the rules comparing fields, they can be registered with [RegisterTag].
+//
+// The returned error reports a malformed tag and is not a validation failure.
+func (v *Validator) ValidateStruct(ctx context.Context, value any) (validation.ErrorBag, error) {
+	rv := reflect.ValueOf(value)
+	if indirect(rv).Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validation: ValidateStruct expects a struct, got %T", value)
+	}
+	c := &structCollector{walking: make(map[walkKey]bool)}
+	if err := c.collectStruct(rv, nil, false); err != nil {
+		return nil, err
+	}
+	return v.Validate(ctx, c.builders...), nil
+}
+
+// ValidateStruct validates the given struct by its "validate" tags with the default validator.
+func ValidateStruct(ctx context.Context, value any) (validation.ErrorBag, error) {
+	return defaultValidator.Load().ValidateStruct(ctx, value)
+}
+
+// structCollector collects the builders of the fields of a struct.
+// It tracks the pointers being walked, so that a value referencing itself, e.g. n.Next = n, is walked once.
+type structCollector struct {
+	builders []validation.ValidatorBuilder
+	walking  map[walkKey]bool
+}
+
+type walkKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// enter follows the pointers and interfaces of the value and marks the pointers as being walked,
+// it returns false if one of them is already being walked. leave unmarks them.
+func (c *structCollector) enter(value reflect.Value) (elem reflect.Value, leave func(), ok bool) {
+	var keys []walkKey
+	leave = func() {
+		for _, key := range keys {
+			delete(c.walking, key)
+		}
+	}
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}, leave, true
+		}
+		if value.Kind() == reflect.Pointer {
+			key := walkKey{value.Pointer(), value.Type()}
+			if c.walking[key] {
+				leave()
+				return reflect.Value{}, func() {}, false
+			}
+			c.walking[key] = true
+			keys = append(keys, key)
+		}
+		value = value.Elem()
+	}
+	return value, leave, true
+}
+
+// collectStruct collects the fields of the struct, validated reports whether the struct is validated as a [validation.Validatable] already.
+func (c *structCollector) collectStruct(value reflect.Value, paths []string, validated bool) error {
+	elem, leave, ok := c.enter(value)
+	if !ok {
+		return nil
+	}
+	defer leave()
+	if elem.Kind() != reflect.Struct {
+		return nil
+	}
+	typ := elem.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if jsonName == "-" {
+			jsonName = ""
+		}
+		var err error
+		if field.Anonymous && jsonName == "" && isStructType(field.Type) {
+			// embedded structs without a json name are flattened like encoding/json does,
+			// their own tag applies to the embedding struct.
+			attribute := ""
+			if len(paths) > 0 {
+				attribute = paths[len(paths)-1]
+			}
+			err = c.collectField(elem.Field(i), attribute, paths, tag, validated)
+		} else {
+			name := field.Name
+			if jsonName != "" {
+				name = jsonName
+			}
+			err = c.collectField(elem.Field(i), name, append(paths[:len(paths):len(paths)], name), tag, false)
+		}
+		if err != nil {
+			return fmt.Errorf("%w (field %s.%s)", err, typ, field.Name)
+		}
+	}
+	return nil
+}
+
+// collectField collects the tag rules of the field and its nested fields,
+// validated reports whether the field is validated as a [validation.Validatable] already, e.g. by a promoted method.
+func (c *structCollector) collectField(value reflect.Value, attribute string, paths []string, tag string, validated bool) error {
+	if tag != "" {
+		rules, err := parseTag(tag, value)
+		if err != nil {
+			return err
+		}
+		if len(rules) > 0 {
+			c.builders = append(c.builders, NewBuilder(validator.Group(rules...).SetValue(value)).SetPath(paths).SetAttribute(attribute))
+		}
+	}
+	if value.Kind() == reflect.Struct && value.CanAddr() {
+		value = value.Addr()
+	}
+	if v, ok := value.Interface().(validation.Validatable); ok && !isNil(value) && !validated {
+		c.builders = append(c.builders, NewBuilder(v).SetPath(paths).SetAttribute(attribute))
+		validated = true
+	}
+	elem := indirect(value)
+	if !elem.IsValid() {
+		return nil
+	}
+	switch elem.Kind() {
+	case reflect.Struct:
+		return c.collectStruct(value, paths, validated)
+	case reflect.Slice, reflect.Array:
+		if !isStructLike(elem.Type().Elem()) && !isValidatable(elem.Type().Elem()) {
+			return nil
+		}
+		for i := 0; i < elem.Len(); i++ {
+			if err := c.collectElem(elem.Index(i), append(paths[:len(paths):len(paths)], strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if !isStructLike(elem.Type().Elem()) && !isValidatable(elem.Type().Elem()) {
+			return nil
+		}
+		// map keys are sorted so that the errors are reported in a stable order
+		keys := elem.MapKeys()
+		names := make([]string, len(keys))
+		for i, key := range keys {
+			names[i] = fmt.Sprint(key.Interface())
+		}
+		indexes := make([]int, len(keys))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		sort.SliceStable(indexes, func(i, j int) bool { return names[indexes[i]] < names[indexes[j]] })
+		for _, i := range indexes {
+			if err := c.collectElem(elem.MapIndex(keys[i]), append(paths[:len(paths):len(paths)], names[i])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectElem collects an element of a slice or a map, which is validated as a [validation.Validatable] if it implements it,
+// the key of the element is its attribute.
+func (c *structCollector) collectElem(value reflect.Value, paths []string) error {
+	return c.collectField(value, joinPaths(paths).String(), paths, "", false)
+}
+
+// parseTag parses the tag into rules applied to the given value.
+func parseTag(tag string, value reflect.Value) ([]validation.Rule[reflect.Value], error) {
+	var rules []validation.Rule[reflect.Value]
+	var omitEmpty, bail bool
+	for _, item := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(item), "=")
+		switch name {
+		case "":
+			continue
+		case "omitempty":
+			omitEmpty = true
+			continue
+		case "bail":
+			bail = true
+			continue
+		}
+		r, ok := tagRules.Load(name)
+		if !ok {
+			return nil, fmt.Errorf("validation: unknown tag %q", name)
+		}
+		rule := r.(tagRule)
+		// the rule is built and checked against the field eagerly, so that malformed tags are reported
+		// even when the rule is not reached, nil fields are checked against their type.
+		var v validation.Validatable
+		if elem := indirect(value); elem.IsValid() {
+			var err error
+			if v, err = rule(param, elem); err != nil {
+				return nil, err
+			}
+		} else if typ := elemType(value.Type()); typ != nil {
+			if _, err := rule(param, reflect.Zero(typ)); err != nil {
+				return nil, err
+			}
+		}
+		rules = append(rules, validator.RuleFunc[reflect.Value](func(ctx context.Context, builder validation.ErrorBuilder, _ reflect.Value) validation.Error {
+			if v == nil {
+				if name == "required" || name == "not_blank" {
+					return builder.BuildError(code.IsNotBlank, message.IsNotBlank)
+				}
+				return nil
+			}
+			return v.Validate(ctx, builder)
+		}))
+	}
+	if bail {
+		rules = []validation.Rule[reflect.Value]{validator.Bail(rules...)}
+	}
+	if omitEmpty {
+		return []validation.Rule[reflect.Value]{
+			validator.RuleFunc[reflect.Value](func(ctx context.Context, builder validation.ErrorBuilder, value reflect.Value) validation.Error {
+				if isBlank(value) {
+					return nil
+				}
+				return validator.Group(rules...).Validate(ctx, builder, value)
+			}),
+		}, nil
+	}
+	return rules, nil
+}
+
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// elemType returns the type pointed to by the type, or nil for interfaces whose dynamic type is unknown.
+func elemType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() == reflect.Interface {
+		return nil
+	}
+	return typ
+}
+
+// isStructType reports whether the type is a struct or a pointer to a struct.
+func isStructType(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct
+}
+
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return value.IsNil()
+	}
+	return false
+}
+
+// isBlank reports whether the value, or the value it points to, is blank, see [is.Blank].
+func isBlank(value reflect.Value) bool {
+	value = indirect(value)
+	return !value.IsValid() || is.Blank(value.Interface())
+}
+
+func isStructLike(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct || typ.Kind() == reflect.Interface
+}
+
+var validatableType = reflect.TypeOf((*validation.Validatable)(nil)).Elem()
+
+// isValidatable reports whether the type or a pointer to it implements [validation.Validatable].
+func isValidatable(typ reflect.Type) bool {
+	return typ.Implements(validatableType) || reflect.PointerTo(typ).Implements(validatableType)
+}
+
+func unsupported(name string, value reflect.Value) error {
+	return fmt.Errorf("validation: tag %q does not support type %s", name, value.Type())
+}
+
+func requiredTag(_ string, value reflect.Value) (validation.Validatable, error) {
+	return validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+		if isBlank(value) {
+			return builder.BuildError(code.IsNotBlank, message.IsNotBlank)
+		}
+		return nil
+	}), nil
+}
+
+func blankTag(_ string, value reflect.Value) (validation.Validatable, error) {
+	return validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+		if !isBlank(value) {
+			return builder.BuildError(code.IsBlank, message.IsBlank)
+		}
+		return nil
+	}), nil
+}
+
+// orderedTag dispatches the tag to the rule instantiated for the kind of the field.
+func orderedTag(
+	name string,
+	s func(string) validator.RuleFunc[string],
+	i func(int64) validator.RuleFunc[int64],
+	u func(uint64) validator.RuleFunc[uint64],
+	f func(float64) validator.RuleFunc[float64],
+) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		switch value.Kind() {
+		case reflect.String:
+			return s(param).SetValue(value.String()), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(param, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+			}
+			return i(n).SetValue(value.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			n, err := strconv.ParseUint(param, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+			}
+			return u(n).SetValue(value.Uint()), nil
+		case reflect.Float32, reflect.Float64:
+			n, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+			}
+			return f(n).SetValue(value.Float()), nil
+		}
+		return nil, unsupported(name, value)
+	}
+}
+
+// listTag dispatches the tag with space separated parameters to the rule instantiated for the kind of the field.
+func listTag(
+	name string,
+	s func(...string) validator.RuleFunc[string],
+	i func(...int64) validator.RuleFunc[int64],
+	u func(...uint64) validator.RuleFunc[uint64],
+	f func(...float64) validator.RuleFunc[float64],
+) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		params := strings.Fields(param)
+		switch value.Kind() {
+		case reflect.String:
+			return s(params...).SetValue(value.String()), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			values, err := parseList(name, params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return nil, err
+			}
+			return i(values...).SetValue(value.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			values, err := parseList(name, params, func(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) })
+			if err != nil {
+				return nil, err
+			}
+			return u(values...).SetValue(value.Uint()), nil
+		case reflect.Float32, reflect.Float64:
+			values, err := parseList(name, params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return nil, err
+			}
+			return f(values...).SetValue(value.Float()), nil
+		}
+		return nil, unsupported(name, value)
+	}
+}
+
+func parseList[T any](name string, params []string, parse func(string) (T, error)) ([]T, error) {
+	values := make([]T, 0, len(params))
+	for _, param := range params {
+		v, err := parse(param)
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// stringTag applies a parameterless string rule.
+func stringTag(name string, rule func() validator.StringRuleFunc) tagRule {
+	return func(_ string, value reflect.Value) (validation.Validatable, error) {
+		if value.Kind() != reflect.String {
+			return nil, unsupported(name, value)
+		}
+		return rule().SetValue(value.String()), nil
+	}
+}
+
+// stringParamTag applies a string rule with a string parameter.
+func stringParamTag(name string, rule func(string) validator.StringRuleFunc) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		if value.Kind() != reflect.String {
+			return nil, unsupported(name, value)
+		}
+		return rule(param).SetValue(value.String()), nil
+	}
+}
+
+// stringListTag applies a string rule with space separated string parameters.
+func stringListTag(name string, rule func(...string) validator.StringRuleFunc) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		if value.Kind() != reflect.String {
+			return nil, unsupported(name, value)
+		}
+		return rule(strings.Fields(param)...).SetValue(value.String()), nil
+	}
+}
+
+// patternTag applies a regular expression rule, the pattern is checked when the tag is parsed.
+func patternTag(name string, rule func(string) validator.StringRuleFunc) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		if value.Kind() != reflect.String {
+			return nil, unsupported(name, value)
+		}
+		if _, err := regexp.Compile(param); err != nil {
+			return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+		}
+		return rule(param).SetValue(value.String()), nil
+	}
+}
+
+// lengthTag applies a string rule with an integer parameter.
+func lengthTag(name string, rule func(int) validator.StringRuleFunc) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		if value.Kind() != reflect.String {
+			return nil, unsupported(name, value)
+		}
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+		}
+		return rule(n).SetValue(value.String()), nil
+	}
+}
+
+// countTag applies a slice rule with an integer parameter.
+func countTag(name string, rule func(int) validator.SliceRuleFunc[any]) tagRule {
+	return func(param string, value reflect.Value) (validation.Validatable, error) {
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			return nil, unsupported(name, value)
+		}
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid parameter of tag %q: %w", name, err)
+		}
+		return rule(n).SetValue(toSlice(value)), nil
+	}
+}
+
+func uniqueTag(_ string, value reflect.Value) (validation.Validatable, error) {
+	if (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) || !value.Type().Elem().Comparable() {
+		return nil, unsupported("unique", value)
+	}
+	return validator.IsUnique[any]().SetValue(toSlice(value)), nil
+}
+
+func toSlice(value reflect.Value) []any {
+	s := make([]any, value.Len())
+	for i := range s {
+		s[i] = value.Index(i).Interface()
+	}
+	return s
+}
+
+func init() {
+	builtin := map[string]tagRule{
+		"required":  requiredTag,
+		"not_blank": requiredTag,
+		"blank":     blankTag,
+
+		"eq":                       orderedTag("eq", validator.IsEqualTo[string], validator.IsEqualTo[int64], validator.IsEqualTo[uint64], validator.IsEqualTo[float64]),
+		"ne":                       orderedTag("ne", validator.IsNotEqualTo[string], validator.IsNotEqualTo[int64], validator.IsNotEqualTo[uint64], validator.IsNotEqualTo[float64]),
+		"lt":                       orderedTag("lt", validator.IsLessThan[string], validator.IsLessThan[int64], validator.IsLessThan[uint64], validator.IsLessThan[float64]),
+		"lte":                      orderedTag("lte", validator.IsLessThanOrEqualTo[string], validator.IsLessThanOrEqualTo[int64], validator.IsLessThanOrEqualTo[uint64], validator.IsLessThanOrEqualTo[float64]),
+		"gt":                       orderedTag("gt", validator.IsGreaterThan[string], validator.IsGreaterThan[int64], validator.IsGreaterThan[uint64], validator.IsGreaterThan[float64]),
+		"gte":                      orderedTag("gte", validator.IsGreaterThanOrEqualTo[string], validator.IsGreaterThanOrEqualTo[int64], validator.IsGreaterThanOrEqualTo[uint64], validator.IsGreaterThanOrEqualTo[float64]),
+		"equal":                    orderedTag("equal", validator.IsEqualTo[string], validator.IsEqualTo[int64], validator.IsEqualTo[uint64], validator.IsEqualTo[float64]),
+		"not_equal":                orderedTag("not_equal", validator.IsNotEqualTo[string], validator.IsNotEqualTo[int64], validator.IsNotEqualTo[uint64], validator.IsNotEqualTo[float64]),
+		"less_than":                orderedTag("less_than", validator.IsLessThan[string], validator.IsLessThan[int64], validator.IsLessThan[uint64], validator.IsLessThan[float64]),
+		"less_than_or_equal_to":    orderedTag("less_than_or_equal_to", validator.IsLessThanOrEqualTo[string], validator.IsLessThanOrEqualTo[int64], validator.IsLessThanOrEqualTo[uint64], validator.IsLessThanOrEqualTo[float64]),
+		"greater_than":             orderedTag("greater_than", validator.IsGreaterThan[string], validator.IsGreaterThan[int64], validator.IsGreaterThan[uint64], validator.IsGreaterThan[float64]),
+		"greater_than_or_equal_to": orderedTag("greater_than_or_equal_to", validator.IsGreaterThanOrEqualTo[string], validator.IsGreaterThanOrEqualTo[int64], validator.IsGreaterThanOrEqualTo[uint64], validator.IsGreaterThanOrEqualTo[float64]),
+
+		"in":     listTag("in", validator.IsIn[string], validator.IsIn[int64], validator.IsIn[uint64], validator.IsIn[float64]),
+		"not_in": listTag("not_in", validator.IsNotIn[string], validator.IsNotIn[int64], validator.IsNotIn[uint64], validator.IsNotIn[float64]),
+
+		"length":              lengthTag("length", validator.IsLength),
+		"min_length":          lengthTag("min_length", validator.IsMinLength),
+		"max_length":          lengthTag("max_length", validator.IsMaxLength),
+		"starts_with":         stringParamTag("starts_with", validator.IsStartsWith),
+		"starts_with_any":     stringListTag("starts_with_any", validator.IsStartsWithAny),
+		"not_starts_with":     stringParamTag("not_starts_with", validator.IsNotStartsWith),
+		"not_starts_with_any": stringListTag("not_starts_with_any", validator.IsNotStartsWithAny),
+		"ends_with":           stringParamTag("ends_with", validator.IsEndsWith),
+		"ends_with_any":       stringListTag("ends_with_any", validator.IsEndsWithAny),
+		"not_ends_with":       stringParamTag("not_ends_with", validator.IsNotEndsWith),
+		"not_ends_with_any":   stringListTag("not_ends_with_any", validator.IsNotEndsWithAny),
+		"match":               patternTag("match", validator.IsMatch),
+		"not_match":           patternTag("not_match", validator.IsNotMatch),
+		"contains":            stringParamTag("contains", validator.IsContains),
+		"not_contains":        stringParamTag("not_contains", validator.IsNotContains),
+		"upper":               stringTag("upper", validator.IsUpper),
+		"lower":               stringTag("lower", validator.IsLower),
+		"alpha":               stringTag("alpha", validator.IsAlpha),
+		"alpha_numeric":       stringTag("alpha_numeric", validator.IsAlphaNumeric),
+		"alpha_dash":          stringTag("alpha_dash", validator.IsAlphaDash),
+		"ascii":               stringTag("ascii", validator.IsAscii),
+		"ascii_numeric":       stringTag("ascii_numeric", validator.IsAsciiNumeric),
+		"ascii_dash":          stringTag("ascii_dash", validator.IsAsciiDash),
+		"number":              stringTag("number", validator.IsNumber),
+		"positive_number":     stringTag("positive_number", validator.IsPositiveNumber),
+		"negative_number":     stringTag("negative_number", validator.IsNegativeNumber),
+		"integer":             stringTag("integer", validator.IsInteger),
+		"positive_integer":    stringTag("positive_integer", validator.IsPositiveInteger),
+		"negative_integer":    stringTag("negative_integer", validator.IsNegativeInteger),
+		"binary":              stringTag("binary", validator.IsBinary),
+		"octal":               stringTag("octal", validator.IsOctal),
+		"hexadecimal":         stringTag("hexadecimal", validator.IsHexadecimal),
+		"decimal":             stringTag("decimal", validator.IsDecimal),
+
+		"unique":    uniqueTag,
+		"count":     countTag("count", validator.IsCount[any]),
+		"min_count": countTag("min_count", validator.IsMinCount[any]),
+		"max_count": countTag("max_count", validator.IsMaxCount[any]),
+
+		"time":     stringParamTag("time", validator.IsTime),
+		"duration": stringTag("duration", validator.IsDuration),
+		"timezone": stringTag("timezone", validator.IsTimezone),
+
+		"json":        stringTag("json", validator.IsJSON),
+		"json_array":  stringTag("json_array", validator.IsJSONArray),
+		"json_object": stringTag("json_object", validator.IsJSONObject),
+		"json_string": stringTag("json_string", validator.IsJSONString),
+		"uuid":        stringTag("uuid", validator.IsUUID),
+		"uuid_v1":     stringTag("uuid_v1", validator.IsUUIDv1),
+		"uuid_v2":     stringTag("uuid_v2", validator.IsUUIDv2),
+		"uuid_v3":     stringTag("uuid_v3", validator.IsUUIDv3),
+		"uuid_v4":     stringTag("uuid_v4", validator.IsUUIDv4),
+		"uuid_v5":     stringTag("uuid_v5", validator.IsUUIDv5),
+		"ulid":        stringTag("ulid", validator.IsULID),
+		"base64":      stringTag("base64", validator.IsBase64),
+		"base32":      stringTag("base32", validator.IsBase32),
+
+		"ip":              stringTag("ip", validator.IsIP),
+		"ipv4":            stringTag("ipv4", validator.IsIP4),
+		"ip4":             stringTag("ip4", validator.IsIP4),
+		"ipv6":            stringTag("ipv6", validator.IsIP6),
+		"ip6":             stringTag("ip6", validator.IsIP6),
+		"url":             stringTag("url", validator.IsURL),
+		"email":           stringTag("email", validator.IsEmail),
+		"url_with_schema": stringParamTag("url_with_schema", validator.IsURLWithScheme),
+		"request_uri":     stringTag("request_uri", validator.IsRequestURI),
+		"url_query":       stringTag("url_query", validator.IsURLQuery),
+
+		"path_exists":     stringTag("path_exists", validator.IsPathExists),
+		"path_not_exists": stringTag("path_not_exists", validator.IsPathNotExists),
+		"path_file":       stringTag("path_file", validator.IsPathFile),
+		"path_dir":        stringTag("path_dir", validator.IsPathDir),
+		"path_absolute":   stringTag("path_absolute", validator.IsPathAbsolute),
+		"path_relative":   stringTag("path_relative", validator.IsPathRelative),
+	}
+	for name, rule := range builtin {
+		tagRules.Store(name, rule)
+	}
+}
@@ -0,0 +1,355 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,length=5,number"`
+}
+
+type mockItem struct {
+	SKU      string `json:"sku" validate:"required,upper"`
+	Quantity int    `json:"quantity" validate:"gte=1,lte=99"`
+}
+
+type mockOrder struct {
+	Name     string                 `json:"name" validate:"required,min_length=3"`
+	Email    string                 `json:"email,omitempty" validate:"omitempty,contains=@"`
+	Status   string                 `json:"status" validate:"in=pending paid"`
+	Address  *mockAddress           `json:"address" validate:"required"`
+	Items    []mockItem             `json:"items" validate:"min_count=1"`
+	Extras   map[string]mockItem    `json:"extras"`
+	Tags     []string               `json:"tags" validate:"unique,max_count=3"`
+	internal string                 `validate:"required"`
+	Ignored  string                 `validate:"-"`
+	Meta     map[string]interface{} `json:"meta"`
+}
+
+func TestValidator_ValidateStruct(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), &mockOrder{
+			Name:    "gopi",
+			Status:  "paid",
+			Address: &mockAddress{Street: "Main St", Zip: "12345"},
+			Items:   []mockItem{{SKU: "ABC", Quantity: 1}},
+			Tags:    []string{"a", "b"},
+		})
+		if assert.NoError(t, err) {
+			assert.False(t, validated.Fails())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), mockOrder{
+			Name:    "go",
+			Email:   "gopi",
+			Status:  "shipped",
+			Address: &mockAddress{Street: "Main St", Zip: "123"},
+			Items:   []mockItem{{SKU: "ABC", Quantity: 1}, {SKU: "abc", Quantity: 0}},
+			Extras:  map[string]mockItem{"gift": {SKU: "", Quantity: 1}},
+			Tags:    []string{"a", "a"},
+		})
+		if !assert.NoError(t, err) {
+			assert.FailNow(t, err.Error())
+		}
+		if assert.True(t, validated.Fails()) {
+			assert.True(t, validated.FailedAt("name", code.IsMinLength))
+			assert.Equal(t, "name should have length greater than or equal to 3.", validated.GetError("name", code.IsMinLength).Error())
+			assert.True(t, validated.FailedAt("email", code.IsContains))
+			assert.True(t, validated.FailedAt("status", code.IsIn))
+			assert.True(t, validated.FailedAt("address.zip", code.IsLength))
+			assert.False(t, validated.HasError("items.0.sku"))
+			assert.True(t, validated.FailedAt("items.1.sku", code.IsUpper))
+			assert.True(t, validated.FailedAt("items.1.quantity", code.IsGreaterThanOrEqualTo))
+			assert.True(t, validated.FailedAt("extras.gift.sku", code.IsNotBlank))
+			assert.True(t, validated.FailedAt("tags", code.IsUnique))
+			assert.Equal(t, "sku should not be blank.", validated.GetError("extras.gift.sku", code.IsNotBlank).Error())
+		}
+	})
+
+	t.Run("map key with dots", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), mockOrder{
+			Name:    "gopi",
+			Status:  "paid",
+			Address: &mockAddress{Street: "Main St", Zip: "12345"},
+			Items:   []mockItem{{SKU: "ABC", Quantity: 1}},
+			Extras:  map[string]mockItem{"gift.box": {SKU: "", Quantity: 1}},
+		})
+		if !assert.NoError(t, err) {
+			assert.FailNow(t, err.Error())
+		}
+		bag := validated.(*errpack.Bag)
+		assert.Equal(t, []string{`extras.gift\.box.sku`}, bag.Failed())
+		assert.Equal(t, errpack.Path{"extras", "gift.box", "sku"}, bag.Path(`extras.gift\.box.sku`))
+		assert.Equal(t, "/extras/gift.box/sku", bag.Path(`extras.gift\.box.sku`).Pointer())
+		assert.Equal(t, "extras[gift.box][sku]", bag.Path(`extras.gift\.box.sku`).Brackets())
+	})
+
+	t.Run("required nil pointer", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), &mockOrder{
+			Name:   "gopi",
+			Status: "paid",
+			Items:  []mockItem{{SKU: "ABC", Quantity: 1}},
+		})
+		if assert.NoError(t, err) {
+			assert.True(t, validated.FailedAt("address", code.IsNotBlank))
+			assert.False(t, validated.HasError("address.zip"))
+		}
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = v.ValidateStruct(context.Background(), struct {
+			Name string `validate:"unknown"`
+		}{})
+		if assert.Error(t, err) {
+			assert.True(t, strings.Contains(err.Error(), `unknown tag "unknown"`))
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = v.ValidateStruct(context.Background(), struct {
+			Age int `validate:"min_length=3"`
+		}{})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid parameter", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = v.ValidateStruct(context.Background(), struct {
+			Age int `validate:"gt=eighteen"`
+		}{})
+		assert.Error(t, err)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = v.ValidateStruct(context.Background(), "gopi")
+		assert.Error(t, err)
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		node := &mockNode{}
+		node.Next = node
+		node.Children = []*mockNode{node, {Next: node}}
+		validated, err := v.ValidateStruct(context.Background(), node)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"name", "children.1.name"}, validated.Failed())
+		}
+	})
+
+	t.Run("embedded struct", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), mockReport{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"reviewer", "named.reviewer", "audit.reviewer"}, validated.Failed())
+		}
+		validated, err = v.ValidateStruct(context.Background(), &mockDocument{mockOwner: &mockOwner{}})
+		if assert.NoError(t, err) {
+			// unexported embedded structs are skipped like unexported fields.
+			assert.Equal(t, []string{"title"}, validated.Failed())
+		}
+	})
+
+	t.Run("nil field with malformed tag", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = v.ValidateStruct(context.Background(), struct {
+			Age *int `validate:"min_length=3"`
+		}{})
+		assert.Error(t, err)
+		_, err = v.ValidateStruct(context.Background(), struct {
+			Age *int `validate:"gt=eighteen"`
+		}{})
+		assert.Error(t, err)
+		validated, err := v.ValidateStruct(context.Background(), struct {
+			Age *int `validate:"required,gt=18"`
+		}{})
+		if assert.NoError(t, err) {
+			assert.True(t, validated.FailedAt("Age", code.IsNotBlank))
+		}
+	})
+
+	t.Run("validatable field", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), struct {
+			User *mockUser `json:"user"`
+		}{User: &mockUser{Username: "gopi", Password: "123", Age: 25}})
+		if assert.NoError(t, err) {
+			assert.True(t, validated.FailedAt("user.password", code.IsMinLength))
+		}
+	})
+
+	t.Run("validatable elements", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), struct {
+			Users   []*mockUser          `json:"users"`
+			Admins  map[string]*mockUser `json:"admins"`
+			Coupons []mockCoupon         `json:"coupons"`
+		}{
+			Users:   []*mockUser{{Username: "gopi", Password: "password123", Age: 25}, {Username: "gopi", Password: "123", Age: 25}},
+			Admins:  map[string]*mockUser{"root": {Username: "root", Password: "password123", Age: 16}},
+			Coupons: []mockCoupon{"SAVE10", "save"},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"users.1.password", "admins.root.age", "coupons.1"}, validated.Failed())
+			assert.Equal(t, "coupons.1 should be uppercase.", validated.GetError("coupons.1", code.IsUpper).Error())
+		}
+	})
+
+	t.Run("built-in names", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := v.ValidateStruct(context.Background(), struct {
+			Email string `json:"email" validate:"required,min_length=3,email"`
+			Age   int    `json:"age" validate:"greater_than_or_equal_to=18,less_than=130"`
+		}{Email: "gopi@", Age: 16})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "email should be a valid email address.", validated.GetError("email", code.IsEmail).Error())
+			assert.True(t, validated.FailedAt("age", code.IsGreaterThanOrEqualTo))
+			assert.False(t, validated.FailedAt("age", code.IsLessThan))
+		}
+	})
+}
+
+// mockCoupon is a validatable value that is not a struct.
+type mockCoupon string
+
+func (c mockCoupon) Validate(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+	return validator.IsUpper().Validate(ctx, builder, string(c))
+}
+
+type mockNode struct {
+	Name     string      `json:"name" validate:"required"`
+	Next     *mockNode   `json:"next"`
+	Children []*mockNode `json:"children"`
+}
+
+type mockTimestamps struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type mockDocument struct {
+	mockTimestamps
+	*mockOwner
+	Title string `json:"title" validate:"required"`
+}
+
+type mockOwner struct {
+	Owner string `json:"owner" validate:"required"`
+}
+
+type MockAudit struct {
+	Reviewer string `json:"reviewer" validate:"required"`
+}
+
+type mockReport struct {
+	MockAudit
+	Named  MockAudit `json:"named"`
+	Tagged MockAudit `json:"audit"`
+}
+
+type mockEmail string
+
+func TestRegisterTag(t *testing.T) {
+	RegisterTag("mailbox", func(param string) (validation.Rule[string], error) {
+		return validator.IsMatch(`^[^@\s]+@[^@\s]+$`), nil
+	})
+	RegisterTag("divisible_by", func(param string) (validation.Rule[int], error) {
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, err
+		} else if n == 0 {
+			return nil, errors.New("divisible_by: zero")
+		}
+		return validator.RuleFunc[int](func(ctx context.Context, builder validation.ErrorBuilder, value int) validation.Error {
+			if value%n != 0 {
+				return builder.BuildError("is_divisible_by", "{{.attribute}} should be divisible by {{.n}}.", errpack.NewParam("n", n))
+			}
+			return nil
+		}), nil
+	})
+	defer tagRules.Delete("mailbox")
+	defer tagRules.Delete("divisible_by")
+
+	v, err := NewValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated, err := v.ValidateStruct(context.Background(), struct {
+		Email mockEmail `validate:"required,mailbox"`
+		Count int       `validate:"divisible_by=2"`
+	}{Email: "gopi", Count: 3})
+	if assert.NoError(t, err) {
+		assert.True(t, validated.FailedAt("Email", code.IsMatch))
+		assert.Equal(t, "Count should be divisible by 2.", validated.GetError("Count", "is_divisible_by").Error())
+	}
+
+	_, err = v.ValidateStruct(context.Background(), struct {
+		Count int `validate:"divisible_by=0"`
+	}{})
+	assert.Error(t, err)
+
+	_, err = v.ValidateStruct(context.Background(), struct {
+		Count float64 `validate:"divisible_by=2"`
+	}{})
+	assert.Error(t, err)
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/translator"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -449,6 +451,29 @@ func TestBefore(t *testing.T) {
 		validated := v.Validate(context.Background(), Before("value", a, time.DateTime, b))
 		if assert.True(t, validated.Fails()) {
 			assert.Equal(t, "value should be before \""+b.Format(time.DateTime)+"\".", validated.GetError("value", code.IsBefore).Error())
+			params := errpack.ParamValues(validated.GetError("value", code.IsBefore))
+			assert.Equal(t, b, params["time"])
+			assert.Equal(t, time.DateTime, params["layout"])
+		}
+	})
+	t.Run("invalid time", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated := v.Validate(context.Background(), Before("value", "2024-09-02", time.DateTime, time.Now()))
+		if assert.True(t, validated.FailedAt("value", code.IsTime)) {
+			assert.Equal(t, "value should be a valid time in format \""+time.DateTime+"\".", validated.GetError("value", code.IsTime).Error())
+		}
+	})
+	t.Run("translated", func(t *testing.T) {
+		if err := translator.LoadBuiltin("de"); err != nil {
+			t.Fatal(err)
+		}
+		b := time.Date(2024, 9, 2, 10, 30, 0, 0, time.UTC)
+		validated := Validate(BindLanguage(context.Background(), "de"), Before("value", "2024-09-03", time.DateOnly, b))
+		if assert.True(t, validated.Fails()) {
+			assert.Equal(t, "value muss vor \"2024-09-02\" liegen.", validated.GetError("value", code.IsBefore).Error())
 		}
 	})
 }
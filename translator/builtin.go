@@ -0,0 +1,62 @@
+package translator
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+//go:embed lang/*.json
+var builtin embed.FS
+
+// BuiltinLanguages returns the languages of the bundled catalogs, e.g. "de", "zh-CN".
+func BuiltinLanguages() []string {
+	entries, _ := builtin.ReadDir("lang")
+	languages := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		languages = append(languages, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// LoadBuiltin registers the bundled catalog of the given language, e.g. LoadBuiltin("de").
+// Messages registered before for the language are kept unless the catalog overrides them.
+func LoadBuiltin(language string) error {
+	for _, name := range BuiltinLanguages() {
+		if normalize(name) != normalize(language) {
+			continue
+		}
+		messages, err := builtinMessages(name)
+		if err != nil {
+			return err
+		}
+		return RegisterTranslation(name, messages)
+	}
+	return fmt.Errorf("translator: no builtin translation for language %q", language)
+}
+
+// LoadAllBuiltin registers the bundled catalogs of all languages returned by [BuiltinLanguages].
+func LoadAllBuiltin() error {
+	for _, language := range BuiltinLanguages() {
+		if err := LoadBuiltin(language); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func builtinMessages(language string) (map[string]string, error) {
+	content, err := builtin.ReadFile("lang/" + language + ".json")
+	if err != nil {
+		return nil, err
+	}
+	messages := make(map[string]string)
+	if err := json.Unmarshal(content, &messages); err != nil {
+		return nil, fmt.Errorf("translator: invalid builtin translation %q: %w", language, err)
+	}
+	return messages, nil
+}
@@ -0,0 +1,121 @@
+package translator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// allCodes returns every constant declared in the code package,
+// so that a new code without translations fails the tests below.
+func allCodes(t *testing.T) []string {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "../code/code.go", nil, 0)
+	require.NoError(t, err)
+	var codes []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for _, value := range spec.Values {
+			if lit, ok := value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				c, err := strconv.Unquote(lit.Value)
+				require.NoError(t, err)
+				codes = append(codes, c)
+			}
+		}
+		return true
+	})
+	require.NotEmpty(t, codes)
+	return codes
+}
+
+var (
+	actionPattern   = regexp.MustCompile(`{{.*?}}`)
+	variablePattern = regexp.MustCompile(`\.(\w+)`)
+)
+
+func variables(message string) []string {
+	var names []string
+	for _, action := range actionPattern.FindAllString(message, -1) {
+		for _, match := range variablePattern.FindAllStringSubmatch(action, -1) {
+			names = append(names, match[1])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestBuiltin_Coverage(t *testing.T) {
+	codes := allCodes(t)
+	fallbacks := make(map[string]string)
+	for _, c := range codes {
+		tmpl, ok := fallback.Load(c)
+		if assert.True(t, ok, "code %q has no English message", c) {
+			fallbacks[c] = tmpl.(*template.Template).Root.String()
+		}
+	}
+	for _, language := range BuiltinLanguages() {
+		messages, err := builtinMessages(language)
+		require.NoError(t, err, language)
+		for _, c := range codes {
+			message, ok := messages[c]
+			if !assert.True(t, ok, "code %q has no message in builtin language %q", c, language) {
+				continue
+			}
+			_, err := newTemplate(c).Parse(message)
+			assert.NoError(t, err, "%s: %s", language, c)
+			assert.Equal(t, variables(fallbacks[c]), variables(message), "%s: %s", language, c)
+		}
+		for key, message := range messages {
+			c, category, plural := strings.Cut(key, ".")
+			assert.Contains(t, codes, c, "builtin language %q has a message for unknown code %q", language, key)
+			if plural {
+				assert.Contains(t, []string{Zero, One, Two, Few, Many, Other}, category, "%s: %s", language, key)
+				assert.Equal(t, variables(fallbacks[c]), variables(message), "%s: %s", language, key)
+			}
+		}
+	}
+}
+
+func TestBuiltinLanguages(t *testing.T) {
+	languages := BuiltinLanguages()
+	for _, language := range []string{"zh-CN", "zh-TW", "ja", "ko", "de", "fr", "es", "pt-BR", "ru"} {
+		assert.Contains(t, languages, language)
+	}
+}
+
+func TestLoadBuiltin(t *testing.T) {
+	require.NoError(t, LoadBuiltin("de"))
+	params := map[string]any{"attribute": "name"}
+	assert.Equal(t, "name darf nicht leer sein.", New().Locale("de").T(code.IsNotBlank, params))
+	assert.Equal(t, "name darf nicht leer sein.", New().Locale("de-AT").T(code.IsNotBlank, params))
+
+	require.NoError(t, LoadBuiltin("pt_br"))
+	assert.Equal(t, "name não deve estar vazio.", New().Locale("pt-BR").T(code.IsNotBlank, params))
+
+	assert.Error(t, LoadBuiltin("unknown"))
+}
+
+func TestLoadBuiltin_Plural(t *testing.T) {
+	require.NoError(t, LoadBuiltin("fr"))
+	require.NoError(t, LoadBuiltin("ru"))
+	fr := New().Locale("fr")
+	assert.Equal(t, "name doit contenir au moins 1 caractère.", fr.P(code.IsMinLength, 1, map[string]any{"attribute": "name", "min": 1}))
+	assert.Equal(t, "name doit contenir au moins 3 caractères.", fr.P(code.IsMinLength, 3, map[string]any{"attribute": "name", "min": 3}))
+	ru := New().Locale("ru")
+	assert.Equal(t, "name должно содержать 1 символ.", ru.P(code.IsLength, 1, map[string]any{"attribute": "name", "length": 1}))
+	assert.Equal(t, "name должно содержать 3 символа.", ru.P(code.IsLength, 3, map[string]any{"attribute": "name", "length": 3}))
+	assert.Equal(t, "name должно содержать 5 символов.", ru.P(code.IsLength, 5, map[string]any{"attribute": "name", "length": 5}))
+}
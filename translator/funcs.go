@@ -0,0 +1,32 @@
+package translator
+
+import (
+	"sync"
+	"text/template"
+
+	"github.com/gopi-frame/validation/errpack"
+)
+
+var funcsMu sync.RWMutex
+var funcs = errpack.Funcs()
+
+// RegisterFuncs registers additional functions for message templates, besides the ones of [errpack.Funcs].
+// Templates are parsed when they are registered, so functions should be registered before the messages using them.
+func RegisterFuncs(fm template.FuncMap) {
+	funcsMu.Lock()
+	defer funcsMu.Unlock()
+	merged := make(template.FuncMap, len(funcs)+len(fm))
+	for name, fn := range funcs {
+		merged[name] = fn
+	}
+	for name, fn := range fm {
+		merged[name] = fn
+	}
+	funcs = merged
+}
+
+func newTemplate(name string) *template.Template {
+	funcsMu.RLock()
+	defer funcsMu.RUnlock()
+	return template.New(name).Funcs(funcs)
+}
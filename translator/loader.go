@@ -0,0 +1,267 @@
+package translator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"gopkg.in/yaml.v3"
+)
+
+// entry is a message read from a catalog file, line is where the message is defined.
+type entry struct {
+	key     string
+	message string
+	line    int
+}
+
+// decoders decode catalog files by extension.
+var decoders = map[string]func(content []byte) ([]entry, error){
+	".json": decodeJSON,
+	".yaml": decodeYAML,
+	".yml":  decodeYAML,
+	".toml": decodeTOML,
+}
+
+// LoadFS registers the catalog files in fsys matching the given pattern (see [fs.Glob]),
+// e.g. LoadFS(os.DirFS("."), "lang/*.yaml") or LoadFS(embedFS, "lang/*").
+//
+// The language of a catalog is the file name without extension, e.g. "lang/de.json" is "de".
+// JSON, YAML and TOML files are supported, nested keys are joined with dots,
+// so that
+//
+//	{"attribute": {"email": "E-Mail"}, "custom": {"email": {"is_match": "..."}}}
+//
+// defines the "attribute.email" and "custom.email.is_match" messages.
+//
+// Files are parsed before any of them is registered, the returned error contains the file and the line
+// of the malformed message.
+func LoadFS(fsys fs.FS, pattern string) error {
+	files, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	catalogs := make(map[string]map[string]*template.Template, len(files))
+	var languages []string
+	for _, file := range files {
+		decode, ok := decoders[strings.ToLower(path.Ext(file))]
+		if !ok {
+			return fmt.Errorf("translator: %s: unsupported file type", file)
+		}
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		entries, err := decode(content)
+		if err != nil {
+			var lineErr *lineError
+			if errors.As(err, &lineErr) {
+				return fmt.Errorf("translator: %s:%d: %w", file, lineErr.line, lineErr.err)
+			}
+			return fmt.Errorf("translator: %s: %w", file, err)
+		}
+		language := strings.TrimSuffix(path.Base(file), path.Ext(file))
+		catalog, ok := catalogs[language]
+		if !ok {
+			catalog = make(map[string]*template.Template)
+			catalogs[language] = catalog
+			languages = append(languages, language)
+		}
+		for _, e := range entries {
+			tmpl, err := newTemplate(e.key).Parse(e.message)
+			if err != nil {
+				return fmt.Errorf("translator: %s:%d: %s: %w", file, e.line, e.key, err)
+			}
+			catalog[e.key] = tmpl
+		}
+	}
+	for _, language := range languages {
+		register(language, catalogs[language])
+	}
+	return nil
+}
+
+// lineError is a decoding error at the given line.
+type lineError struct {
+	line int
+	err  error
+}
+
+func (e *lineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.err)
+}
+
+func (e *lineError) Unwrap() error {
+	return e.err
+}
+
+func decodeJSON(content []byte) ([]entry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	line := func() int {
+		return bytes.Count(content[:decoder.InputOffset()], []byte("\n")) + 1
+	}
+	var entries []entry
+	var walk func(prefix string) error
+	walk = func(prefix string) error {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token := token.(type) {
+		case string:
+			if prefix == "" {
+				return errors.New("catalog should be an object")
+			}
+			entries = append(entries, entry{key: prefix, message: token, line: line()})
+			return nil
+		case json.Delim:
+			if token != '{' {
+				return fmt.Errorf("%s: message should be a string or an object", prefix)
+			}
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				if err := walk(join(prefix, key.(string))); err != nil {
+					return err
+				}
+			}
+			_, err := decoder.Token()
+			return err
+		default:
+			return fmt.Errorf("%s: message should be a string or an object", prefix)
+		}
+	}
+	if err := walk(""); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, &lineError{line: line(), err: err}
+	}
+	return entries, nil
+}
+
+func decodeYAML(content []byte) ([]entry, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+	var entries []entry
+	var walk func(prefix string, node *yaml.Node) error
+	walk = func(prefix string, node *yaml.Node) error {
+		switch node.Kind {
+		case yaml.ScalarNode:
+			if prefix == "" {
+				return &lineError{line: node.Line, err: errors.New("catalog should be a mapping")}
+			}
+			entries = append(entries, entry{key: prefix, message: node.Value, line: node.Line})
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if err := walk(join(prefix, node.Content[i].Value), node.Content[i+1]); err != nil {
+					return err
+				}
+			}
+		case yaml.AliasNode:
+			return walk(prefix, node.Alias)
+		default:
+			return &lineError{line: node.Line, err: fmt.Errorf("%s: message should be a string or a mapping", prefix)}
+		}
+		return nil
+	}
+	if err := walk("", document.Content[0]); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func decodeTOML(content []byte) ([]entry, error) {
+	// the decoder validates the document, the parser gives the lines of the messages
+	decodeErr := toml.Unmarshal(content, &map[string]any{})
+	var syntaxErr *toml.DecodeError
+	if errors.As(decodeErr, &syntaxErr) {
+		line, _ := syntaxErr.Position()
+		return nil, &lineError{line: line, err: decodeErr}
+	}
+	var parser unstable.Parser
+	parser.Reset(content)
+	line := func(node *unstable.Node) int {
+		return parser.Shape(node.Raw).Start.Line
+	}
+	key := func(prefix string, node *unstable.Node) (string, int) {
+		keys := node.Key()
+		var first *unstable.Node
+		for keys.Next() {
+			if first == nil {
+				first = keys.Node()
+			}
+			prefix = join(prefix, string(keys.Node().Data))
+		}
+		return prefix, line(first)
+	}
+	var entries []entry
+	defined := make(map[string]struct{})
+	var walk func(prefix string, node *unstable.Node) error
+	walk = func(prefix string, node *unstable.Node) error {
+		key, keyLine := key(prefix, node)
+		value := node.Value()
+		switch value.Kind {
+		case unstable.String:
+			if _, ok := defined[key]; ok {
+				return &lineError{line: keyLine, err: fmt.Errorf("%s: message is already defined", key)}
+			}
+			defined[key] = struct{}{}
+			entries = append(entries, entry{key: key, message: string(value.Data), line: line(value)})
+		case unstable.InlineTable:
+			children := value.Children()
+			for children.Next() {
+				if err := walk(key, children.Node()); err != nil {
+					return err
+				}
+			}
+		default:
+			return &lineError{line: keyLine, err: fmt.Errorf("%s: message should be a string or a table", key)}
+		}
+		return nil
+	}
+	var prefix string
+	for parser.NextExpression() {
+		node := parser.Expression()
+		switch node.Kind {
+		case unstable.Table:
+			prefix, _ = key("", node)
+		case unstable.ArrayTable:
+			table, tableLine := key("", node)
+			return nil, &lineError{line: tableLine, err: fmt.Errorf("%s: message should be a string or a table", table)}
+		case unstable.KeyValue:
+			if err := walk(prefix, node); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := parser.Error(); err != nil {
+		return nil, err
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	return entries, nil
+}
+
+func join(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,103 @@
+package translator
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"lang/test-load-json.json": {Data: []byte(`{
+  "is_not_blank": "{{.attribute}} darf nicht leer sein.",
+  "attribute": {
+    "email": "E-Mail"
+  },
+  "custom": {
+    "email": {"is_match": "{{.attribute}} ist keine gültige Adresse."}
+  }
+}`)},
+		"lang/test-load-yaml.yaml": {Data: []byte(`is_not_blank: "{{.attribute}} ne doit pas être vide."
+attribute:
+  email: courriel
+custom:
+  email:
+    is_match: "{{.attribute}} n'est pas une adresse valide."
+`)},
+		"lang/test-load-toml.toml": {Data: []byte(`# comment
+is_not_blank = "{{.attribute}}不能为空。"
+
+[attribute]
+email = '邮箱' # trailing comment
+
+[custom.email]
+is_match = """
+{{.attribute}}不是\
+ 有效的地址。"""
+`)},
+	}
+	require.NoError(t, LoadFS(fsys, "lang/*"))
+	params := map[string]any{"attribute": "name"}
+
+	tests := []struct {
+		language string
+		blank    string
+		email    string
+		match    string
+	}{
+		{"test-load-json", "name darf nicht leer sein.", "E-Mail", "name ist keine gültige Adresse."},
+		{"test-load-yaml", "name ne doit pas être vide.", "courriel", "name n'est pas une adresse valide."},
+		{"test-load-toml", "name不能为空。", "邮箱", "name不是有效的地址。"},
+	}
+	for _, test := range tests {
+		t.Run(test.language, func(t *testing.T) {
+			tr := New().Locale(test.language)
+			assert.Equal(t, test.blank, tr.T(code.IsNotBlank, params))
+			assert.Equal(t, test.match, tr.T("custom.email.is_match", params))
+			tmpl, ok := tr.(*Translator).lookup("attribute.email")
+			if assert.True(t, ok) {
+				assert.Equal(t, test.email, tmpl.Root.String())
+			}
+		})
+	}
+}
+
+func TestLoadFS_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		err     string
+	}{
+		{"json template", "test-error.json", "{\n  \"is_blank\": \"ok\",\n  \"is_not_blank\": \"{{.attribute\"\n}", "translator: test-error.json:3: is_not_blank: "},
+		{"json syntax", "test-error.json", "{\n  \"is_blank\": \"ok\",\n  \"is_not_blank\" 1\n}", "translator: test-error.json:3: "},
+		{"json value", "test-error.json", "{\n  \"is_blank\": 1\n}", "translator: test-error.json:2: is_blank: message should be a string or an object"},
+		{"yaml template", "test-error.yaml", "is_blank: ok\nattribute:\n  email: \"{{end}}\"\n", "translator: test-error.yaml:3: attribute.email: "},
+		{"yaml value", "test-error.yml", "is_blank:\n  - ok\n", "translator: test-error.yml:2: is_blank: message should be a string or a mapping"},
+		{"toml template", "test-error.toml", "is_blank = \"ok\"\n\n[custom]\nemail = \"{{.attribute\"\n", "translator: test-error.toml:4: custom.email: "},
+		{"toml value", "test-error.toml", "is_blank = \"ok\"\nis_not_blank = 1\n", "translator: test-error.toml:2: is_not_blank: message should be a string or a table"},
+		{"toml inline value", "test-error.toml", "is_blank = \"ok\"\nattribute = { email = [\"E-Mail\"] }\n", "translator: test-error.toml:2: attribute.email: message should be a string or a table"},
+		{"toml string", "test-error.toml", "is_blank = \"ok\n", "translator: test-error.toml:1: "},
+		{"toml duplicate", "test-error.toml", "is_blank = \"ok\"\n\nis_blank = \"ok\"\n", "translator: test-error.toml:3: is_blank: message is already defined"},
+		{"unsupported", "test-error.ini", "is_blank=ok", "translator: test-error.ini: unsupported file type"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := LoadFS(fstest.MapFS{test.file: {Data: []byte(test.content)}}, "*")
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+	// nothing is registered if any of the files is malformed
+	err := LoadFS(fstest.MapFS{
+		"test-error-a.json": {Data: []byte(`{"is_blank": "ok"}`)},
+		"test-error-b.json": {Data: []byte(`{"is_blank": "{{"}`)},
+	}, "*")
+	assert.Error(t, err)
+	_, ok := translations.Load("test-error-a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,119 @@
+package translator
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Languages returns the languages having a registered catalog, normalized and sorted, e.g. "de", "en", "zh-cn".
+func Languages() []string {
+	var languages []string
+	translations.Range(func(key, _ any) bool {
+		languages = append(languages, key.(string))
+		return true
+	})
+	sort.Strings(languages)
+	return languages
+}
+
+// Match returns the registered language best matching the preferred language tags, which are in descending order of preference.
+// For each tag, the tag itself and then its fallback chain without the fallback language are tried, e.g. "zh-Hant-TW", "zh-Hant" and "zh",
+// then a registered language more specific than the tag, e.g. "de-AT" for "de".
+// The returned language is normalized, and false is returned if none of the tags matches.
+func Match(tags ...string) (string, bool) {
+	for _, tag := range tags {
+		tag = normalize(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		for _, language := range fallbackChain(tag) {
+			// the fallback language is only matched when it is asked for.
+			if language == fallbackLanguage && tag != fallbackLanguage && !strings.HasPrefix(tag, fallbackLanguage+"-") {
+				break
+			}
+			if isRegistered(language) {
+				return language, true
+			}
+		}
+		for _, language := range Languages() {
+			if strings.HasPrefix(language, tag+"-") {
+				return language, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Parents returns the normalized language tag followed by its parent languages,
+// e.g. "zh_Hant_TW" returns "zh-hant-tw", "zh-hant" and "zh", and an empty tag returns none.
+// Unlike the languages looked up by a [Translator], the fallback language is not appended.
+func Parents(language string) []string {
+	language = normalize(strings.TrimSpace(language))
+	var chain []string
+	for language != "" {
+		chain = append(chain, language)
+		i := strings.LastIndex(language, "-")
+		if i < 0 {
+			break
+		}
+		language = language[:i]
+	}
+	return chain
+}
+
+func isRegistered(language string) bool {
+	catalog, ok := translations.Load(language)
+	if !ok {
+		return false
+	}
+	empty := true
+	catalog.(*sync.Map).Range(func(_, _ any) bool {
+		empty = false
+		return false
+	})
+	return !empty
+}
+
+// ParseAcceptLanguage returns the language tags of an Accept-Language header in descending order of quality,
+// e.g. "fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5" returns "fr-CH", "fr", "en" and "*".
+// Tags of the same quality keep their order, tags with a zero or malformed quality are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		if params = strings.TrimSpace(params); params != "" {
+			name, value, _ := strings.Cut(params, "=")
+			if strings.TrimSpace(name) != "q" {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q < 0 || q > 1 {
+				continue
+			}
+			quality = q
+		}
+		if quality == 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag, quality})
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tag.tag)
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"", []string{}},
+		{"de", []string{"de"}},
+		{"fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5", []string{"fr-CH", "fr", "en", "de", "*"}},
+		{"en;q=0.5, ja, zh-CN;q=0.8", []string{"ja", "zh-CN", "en"}},
+		{"en;q=0, de;q=abc, fr ; q=0.3, es;level=1", []string{"fr"}},
+		{" , ko ,", []string{"ko"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, ParseAcceptLanguage(test.header), test.header)
+	}
+}
+
+func TestParents(t *testing.T) {
+	assert.Equal(t, []string{"zh-hant-tw", "zh-hant", "zh"}, Parents(" zh_Hant_TW "))
+	assert.Equal(t, []string{"en-gb", "en"}, Parents("en-GB"))
+	assert.Equal(t, []string{"de"}, Parents("de"))
+	assert.Nil(t, Parents(""))
+}
+
+func TestMatch(t *testing.T) {
+	RegisterTranslation("test-match", map[string]string{code.IsBlank: "blank"})
+	RegisterTranslation("test-match-zh-hant", map[string]string{code.IsBlank: "blank"})
+	RegisterTranslation("test-region-de-at", map[string]string{code.IsBlank: "blank"})
+
+	tests := []struct {
+		tags  []string
+		want  string
+		match bool
+	}{
+		{[]string{"test-match"}, "test-match", true},
+		{[]string{"TEST_MATCH_ZH_HANT_TW"}, "test-match-zh-hant", true},
+		{[]string{"test-match-ja"}, "test-match", true},
+		{[]string{"unknown", "test-match-zh-Hant"}, "test-match-zh-hant", true},
+		{[]string{"test-region-de"}, "test-region-de-at", true},
+		{[]string{"unknown-xx", "en"}, "en", true},
+		{[]string{"en-GB"}, "en", true},
+		{[]string{"unknown-xx"}, "", false},
+		{[]string{"*"}, "", false},
+		{nil, "", false},
+	}
+	for _, test := range tests {
+		language, ok := Match(test.tags...)
+		assert.Equal(t, test.match, ok, test.tags)
+		assert.Equal(t, test.want, language, test.tags)
+	}
+	assert.Contains(t, Languages(), "test-match-zh-hant")
+}
@@ -0,0 +1,248 @@
+package translator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Plural categories defined by CLDR.
+const (
+	Zero  = "zero"
+	One   = "one"
+	Two   = "two"
+	Few   = "few"
+	Many  = "many"
+	Other = "other"
+)
+
+// Operands are the CLDR plural operands of a number,
+// see https://unicode.org/reports/tr35/tr35-numbers.html#Operands.
+type Operands struct {
+	N float64 // absolute value
+	I int64   // integer digits
+	V int     // number of visible fraction digits, with trailing zeros
+	W int     // number of visible fraction digits, without trailing zeros
+	F int64   // visible fraction digits, with trailing zeros
+	T int64   // visible fraction digits, without trailing zeros
+}
+
+// PluralRule returns the plural category of the operands.
+type PluralRule func(ops Operands) string
+
+var pluralRules = new(sync.Map)
+
+// RegisterPluralRule registers the plural rule of the given language,
+// it replaces the builtin rule of the language if any.
+func RegisterPluralRule(language string, rule PluralRule) {
+	pluralRules.Store(normalize(language), rule)
+}
+
+// PluralCategory returns the plural category of count in the given language, e.g. "one" or "few".
+// Count may be an integer, a float or a numeric string, strings keep their fraction digits, so "1.0" is not "one" in English.
+// Languages without a rule use the English rule.
+func PluralCategory(language string, count any) string {
+	ops, err := NewOperands(count)
+	if err != nil {
+		return Other
+	}
+	return pluralRule(language)(ops)
+}
+
+func pluralRule(language string) PluralRule {
+	for _, l := range fallbackChain(language) {
+		if rule, ok := pluralRules.Load(l); ok {
+			return rule.(PluralRule)
+		}
+	}
+	return pluralOneOther
+}
+
+// NewOperands returns the plural operands of the number.
+func NewOperands(number any) (Operands, error) {
+	var s string
+	switch number := number.(type) {
+	case int:
+		s = strconv.FormatInt(int64(number), 10)
+	case int8:
+		s = strconv.FormatInt(int64(number), 10)
+	case int16:
+		s = strconv.FormatInt(int64(number), 10)
+	case int32:
+		s = strconv.FormatInt(int64(number), 10)
+	case int64:
+		s = strconv.FormatInt(number, 10)
+	case uint:
+		s = strconv.FormatUint(uint64(number), 10)
+	case uint8:
+		s = strconv.FormatUint(uint64(number), 10)
+	case uint16:
+		s = strconv.FormatUint(uint64(number), 10)
+	case uint32:
+		s = strconv.FormatUint(uint64(number), 10)
+	case uint64:
+		s = strconv.FormatUint(number, 10)
+	case float32:
+		s = strconv.FormatFloat(float64(number), 'f', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(number, 'f', -1, 64)
+	case string:
+		s = strings.TrimSpace(number)
+	default:
+		return Operands{}, fmt.Errorf("translator: %T is not a number", number)
+	}
+	s = strings.TrimPrefix(s, "-")
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+		return Operands{}, fmt.Errorf("translator: %q is not a number", s)
+	}
+	ops := Operands{N: n, I: int64(n)}
+	if _, fraction, ok := strings.Cut(s, "."); ok {
+		ops.V = len(fraction)
+		ops.F, _ = strconv.ParseInt(fraction, 10, 64)
+		trimmed := strings.TrimRight(fraction, "0")
+		ops.W = len(trimmed)
+		ops.T, _ = strconv.ParseInt(trimmed, 10, 64)
+	}
+	return ops, nil
+}
+
+func inRange(n, from, to int64) bool {
+	return n >= from && n <= to
+}
+
+func pluralOther(Operands) string {
+	return Other
+}
+
+// pluralOneOther is the rule of English, German, Dutch, Swedish, ...
+func pluralOneOther(ops Operands) string {
+	if ops.I == 1 && ops.V == 0 {
+		return One
+	}
+	return Other
+}
+
+// pluralZeroOneOther is the rule of French and Portuguese, 0 and 1 are singular.
+func pluralZeroOneOther(ops Operands) string {
+	if ops.I == 0 || ops.I == 1 {
+		return One
+	}
+	return Other
+}
+
+// pluralEastSlavic is the rule of Russian, Ukrainian and Belarusian.
+func pluralEastSlavic(ops Operands) string {
+	if ops.V != 0 {
+		return Other
+	}
+	switch i10, i100 := ops.I%10, ops.I%100; {
+	case i10 == 1 && i100 != 11:
+		return One
+	case inRange(i10, 2, 4) && !inRange(i100, 12, 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+func pluralPolish(ops Operands) string {
+	if ops.V != 0 {
+		return Other
+	}
+	switch i10, i100 := ops.I%10, ops.I%100; {
+	case ops.I == 1:
+		return One
+	case inRange(i10, 2, 4) && !inRange(i100, 12, 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+// pluralCzech is the rule of Czech and Slovak.
+func pluralCzech(ops Operands) string {
+	switch {
+	case ops.V != 0:
+		return Many
+	case ops.I == 1:
+		return One
+	case inRange(ops.I, 2, 4):
+		return Few
+	default:
+		return Other
+	}
+}
+
+func pluralArabic(ops Operands) string {
+	if ops.N != math.Trunc(ops.N) {
+		return Other
+	}
+	switch n100 := ops.I % 100; {
+	case ops.N == 0:
+		return Zero
+	case ops.N == 1:
+		return One
+	case ops.N == 2:
+		return Two
+	case inRange(n100, 3, 10):
+		return Few
+	case inRange(n100, 11, 99):
+		return Many
+	default:
+		return Other
+	}
+}
+
+func pluralHebrew(ops Operands) string {
+	switch {
+	case ops.I == 1 && ops.V == 0, ops.I == 0 && ops.V != 0:
+		return One
+	case ops.I == 2 && ops.V == 0:
+		return Two
+	default:
+		return Other
+	}
+}
+
+func pluralWelsh(ops Operands) string {
+	switch ops.N {
+	case 0:
+		return Zero
+	case 1:
+		return One
+	case 2:
+		return Two
+	case 3:
+		return Few
+	case 6:
+		return Many
+	default:
+		return Other
+	}
+}
+
+func init() {
+	for _, language := range []string{"ja", "ko", "zh", "vi", "th", "id", "ms", "lo", "my", "km"} {
+		RegisterPluralRule(language, pluralOther)
+	}
+	for _, language := range []string{"en", "de", "nl", "sv", "da", "nb", "nn", "no", "fi", "et", "it", "es", "ca", "el", "hu", "tr", "bg"} {
+		RegisterPluralRule(language, pluralOneOther)
+	}
+	for _, language := range []string{"fr", "pt", "hy"} {
+		RegisterPluralRule(language, pluralZeroOneOther)
+	}
+	// European Portuguese follows the English rule.
+	RegisterPluralRule("pt-PT", pluralOneOther)
+	for _, language := range []string{"ru", "uk", "be"} {
+		RegisterPluralRule(language, pluralEastSlavic)
+	}
+	RegisterPluralRule("pl", pluralPolish)
+	RegisterPluralRule("cs", pluralCzech)
+	RegisterPluralRule("sk", pluralCzech)
+	RegisterPluralRule("ar", pluralArabic)
+	RegisterPluralRule("he", pluralHebrew)
+	RegisterPluralRule("cy", pluralWelsh)
+}
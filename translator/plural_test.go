@@ -0,0 +1,89 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		language string
+		count    any
+		want     string
+	}{
+		{"en", 0, Other},
+		{"en", 1, One},
+		{"en", 2, Other},
+		{"en", "1.0", Other},
+		{"en-GB", 1, One},
+		{"unknown", 1, One},
+		{"ja", 1, Other},
+		{"zh-Hant-TW", 1, Other},
+		{"fr", 0, One},
+		{"fr", 1.5, One},
+		{"fr", 2, Other},
+		{"pt-BR", 0, One},
+		{"pt-PT", 0, Other},
+		{"ru", 1, One},
+		{"ru", 21, One},
+		{"ru", 11, Many},
+		{"ru", 3, Few},
+		{"ru", 13, Many},
+		{"ru", 25, Many},
+		{"ru", 1.5, Other},
+		{"pl", 1, One},
+		{"pl", 22, Few},
+		{"pl", 21, Many},
+		{"pl", 12, Many},
+		{"cs", 3, Few},
+		{"cs", 5, Other},
+		{"cs", "1.5", Many},
+		{"ar", 0, Zero},
+		{"ar", 2, Two},
+		{"ar", 103, Few},
+		{"ar", 111, Many},
+		{"ar", 100, Other},
+		{"en", int64(-1), One},
+		{"en", uint8(1), One},
+		{"en", "abc", Other},
+		{"en", nil, Other},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, PluralCategory(test.language, test.count), "%s: %v", test.language, test.count)
+	}
+}
+
+func TestRegisterPluralRule(t *testing.T) {
+	RegisterPluralRule("test-plural", func(ops Operands) string {
+		if ops.I%2 == 0 {
+			return Two
+		}
+		return Other
+	})
+	assert.Equal(t, Two, PluralCategory("test-plural", 4))
+	assert.Equal(t, Other, PluralCategory("test-plural-XX", 3))
+}
+
+func TestTranslator_P(t *testing.T) {
+	RegisterTranslation("test-plural-ru", map[string]string{
+		"apples.one":  "{{.count}} яблоко",
+		"apples.few":  "{{.count}} яблока",
+		"apples.many": "{{.count}} яблок",
+		"pears":       "{{.count}} груш",
+	})
+	RegisterPluralRule("test-plural-ru", pluralEastSlavic)
+	tr := New().Locale("test-plural-ru")
+	for count, want := range map[int]string{1: "1 яблоко", 3: "3 яблока", 5: "5 яблок", 21: "21 яблоко", 12: "12 яблок"} {
+		assert.Equal(t, want, tr.P("apples", count, map[string]any{"count": count}))
+	}
+	// keys without plural forms are rendered as is
+	assert.Equal(t, "2 груш", tr.P("pears", 2, map[string]any{"count": 2}))
+	// forms missing in the language fall back to English
+	params := map[string]any{"attribute": "items", "count": 1}
+	assert.Equal(t, "items should contain at least 1 element.", tr.P(code.IsMinCount, 1, params))
+	params["count"] = 3
+	assert.Equal(t, "items should contain at least 3 elements.", tr.P(code.IsMinCount, 3, params))
+	assert.Equal(t, "", tr.P("unknown", 1, nil))
+}
@@ -1,8 +1,10 @@
 package translator
 
 import (
+	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"text/template"
 
 	"github.com/gopi-frame/contract/validation"
@@ -15,155 +17,254 @@ var fallback = new(sync.Map)
 var fallbackLanguage = "en"
 var translations = new(sync.Map)
 
-func RegisterTranslation(language string, messages map[string]string) {
-	t := new(sync.Map)
+var errorHandler atomic.Pointer[func(err error)]
+
+// SetErrorHandler sets the handler receiving the errors of executing templates,
+// the failed message is then translated as an empty string, so that the default message is used.
+// It is safe to call concurrently with translating, a nil handler removes the handler.
+func SetErrorHandler(handler func(err error)) {
+	if handler == nil {
+		errorHandler.Store(nil)
+		return
+	}
+	errorHandler.Store(&handler)
+}
+
+// RegisterTranslation registers messages for the given language.
+// Messages registered for the same language are merged, the later ones win.
+// The messages are parsed first, nothing is registered if any of them is malformed.
+// It is safe to call concurrently with translating.
+func RegisterTranslation(language string, messages map[string]string) error {
+	templates := make(map[string]*template.Template, len(messages))
 	for c, m := range messages {
-		fallback.Store(c, template.Must(template.New(c).Parse(m)))
+		tmpl, err := newTemplate(c).Parse(m)
+		if err != nil {
+			return fmt.Errorf("translator: invalid message %s of language %q: %w", c, language, err)
+		}
+		templates[c] = tmpl
+	}
+	register(language, templates)
+	return nil
+}
+
+// register merges the parsed templates into the catalog of the given language.
+func register(language string, templates map[string]*template.Template) {
+	catalog, _ := translations.LoadOrStore(normalize(language), new(sync.Map))
+	for key, tmpl := range templates {
+		catalog.(*sync.Map).Store(key, tmpl)
+	}
+}
+
+// normalize normalizes the language tag, so that "zh_Hant_TW" and "zh-hant-tw" are the same language.
+func normalize(language string) string {
+	return strings.ToLower(strings.ReplaceAll(language, "_", "-"))
+}
+
+// fallbackChain returns the languages to look up in order,
+// e.g. "zh-Hant-TW" falls back to "zh-Hant", then "zh", then the fallback language.
+func fallbackChain(language string) []string {
+	chain := Parents(language)
+	if len(chain) == 0 || chain[len(chain)-1] != fallbackLanguage {
+		chain = append(chain, fallbackLanguage)
 	}
-	translations.Store(language, t)
+	return chain
 }
 
 type Translator struct {
-	messages *sync.Map
+	languages []string
 }
 
 func New() *Translator {
 	return &Translator{
-		messages: fallback,
+		languages: []string{fallbackLanguage},
 	}
 }
 
+func (t *Translator) lookup(key string) (*template.Template, bool) {
+	for _, language := range t.languages {
+		if catalog, ok := translations.Load(language); ok {
+			if v, ok := catalog.(*sync.Map).Load(key); ok {
+				return v.(*template.Template), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// T renders the message of the key, it returns an empty string if the key is not registered.
+// Attribute display names are registered as "attribute.<name>", e.g. "attribute.email".
 func (t *Translator) T(key string, params map[string]any) string {
-	if strings.HasPrefix(key, "attribute.") {
-		key = key[len("attribute."):]
-		return key
+	if tmpl, ok := t.lookup(key); ok {
+		return execute(tmpl, params)
 	}
+	return ""
+}
+
+func execute(tmpl *template.Template, params map[string]any) string {
 	sb := new(strings.Builder)
-	if v, ok := t.messages.Load(key); ok {
-		if err := v.(*template.Template).Execute(sb, params); err != nil {
-			panic(err)
-		}
-	} else if v, ok := fallback.Load(key); ok {
-		if err := v.(*template.Template).Execute(sb, params); err != nil {
-			panic(err)
+	if err := tmpl.Execute(sb, params); err != nil {
+		if handler := errorHandler.Load(); handler != nil {
+			(*handler)(fmt.Errorf("translator: failed to render %s: %w", tmpl.Name(), err))
 		}
-	} else {
 		return ""
 	}
 	return sb.String()
 }
 
-func (t *Translator) P(key string, _ any, params map[string]any) string {
-	return t.T(key, params)
+// P renders the plural form of the message of the key for count, see [PluralCategory].
+// Plural forms are registered as "<key>.<category>", e.g. "is_min_count.one" and "is_min_count.other".
+// In each language of the fallback chain, the form of the category is looked up first,
+// then the "other" form and then the key itself, so messages without plural forms still work.
+func (t *Translator) P(key string, count any, params map[string]any) string {
+	for _, language := range t.languages {
+		catalog, ok := translations.Load(language)
+		if !ok {
+			continue
+		}
+		category := PluralCategory(language, count)
+		for _, k := range []string{key + "." + category, key + "." + Other, key} {
+			if v, ok := catalog.(*sync.Map).Load(k); ok {
+				return execute(v.(*template.Template), params)
+			}
+		}
+	}
+	return ""
 }
 
+// Locale returns a translator for the given language.
+// Messages missing in the language are looked up along its fallback chain,
+// e.g. "zh-Hant-TW", "zh-Hant", "zh" and then "en".
 func (t *Translator) Locale(language string) validation.Translator {
-	if messages, ok := translations.Load(language); ok {
-		return &Translator{messages: messages.(*sync.Map)}
-	}
-	return t
+	return &Translator{languages: fallbackChain(language)}
 }
 
 func init() {
-	fallback.Store(code.IsBlank, template.Must(template.New(code.IsBlank).Parse(message.IsBlank)))
-	fallback.Store(code.IsNotBlank, template.Must(template.New(code.IsNotBlank).Parse(message.IsNotBlank)))
-	fallback.Store(code.IsIn, template.Must(template.New(code.IsIn).Parse(message.IsIn)))
-	fallback.Store(code.IsNotIn, template.Must(template.New(code.IsNotIn).Parse(message.IsNotIn)))
-	fallback.Store(code.IsEqualTo, template.Must(template.New(code.IsEqualTo).Parse(message.IsEqualTo)))
-	fallback.Store(code.IsNotEqualTo, template.Must(template.New(code.IsNotEqualTo).Parse(message.IsNotEqualTo)))
-	fallback.Store(code.IsLessThan, template.Must(template.New(code.IsLessThan).Parse(message.IsLessThan)))
-	fallback.Store(code.IsLessThanOrEqualTo, template.Must(template.New(code.IsLessThanOrEqualTo).Parse(message.IsLessThanOrEqualTo)))
-	fallback.Store(code.IsGreaterThan, template.Must(template.New(code.IsGreaterThan).Parse(message.IsGreaterThan)))
-	fallback.Store(code.IsGreaterThanOrEqualTo, template.Must(template.New(code.IsGreaterThanOrEqualTo).Parse(message.IsGreaterThanOrEqualTo)))
-
-	fallback.Store(code.IsLength, template.Must(template.New(code.IsLength).Parse(message.IsLength)))
-	fallback.Store(code.IsMinLength, template.Must(template.New(code.IsMinLength).Parse(message.IsMinLength)))
-	fallback.Store(code.IsMaxLength, template.Must(template.New(code.IsMaxLength).Parse(message.IsMaxLength)))
-	fallback.Store(code.IsStartsWith, template.Must(template.New(code.IsStartsWith).Parse(message.IsStartsWith)))
-	fallback.Store(code.IsStartsWithAny, template.Must(template.New(code.IsStartsWithAny).Parse(message.IsStartsWithAny)))
-	fallback.Store(code.IsNotStartsWith, template.Must(template.New(code.IsNotStartsWith).Parse(message.IsNotStartsWith)))
-	fallback.Store(code.IsNotStartsWithAny, template.Must(template.New(code.IsNotStartsWithAny).Parse(message.IsNotStartsWithAny)))
-	fallback.Store(code.IsEndsWith, template.Must(template.New(code.IsEndsWith).Parse(message.IsEndsWith)))
-	fallback.Store(code.IsEndsWithAny, template.Must(template.New(code.IsEndsWithAny).Parse(message.IsEndsWithAny)))
-	fallback.Store(code.IsNotEndsWith, template.Must(template.New(code.IsNotEndsWith).Parse(message.IsNotEndsWith)))
-	fallback.Store(code.IsNotEndsWithAny, template.Must(template.New(code.IsNotEndsWithAny).Parse(message.IsNotEndsWithAny)))
-	fallback.Store(code.IsMatch, template.Must(template.New(code.IsMatch).Parse(message.IsMatch)))
-	fallback.Store(code.IsNotMatch, template.Must(template.New(code.IsNotMatch).Parse(message.IsNotMatch)))
-	fallback.Store(code.IsContains, template.Must(template.New(code.IsContains).Parse(message.IsContains)))
-	fallback.Store(code.IsNotContains, template.Must(template.New(code.IsNotContains).Parse(message.IsNotContains)))
-	fallback.Store(code.IsUpper, template.Must(template.New(code.IsUpper).Parse(message.IsUpper)))
-	fallback.Store(code.IsLower, template.Must(template.New(code.IsLower).Parse(message.IsLower)))
-	fallback.Store(code.IsAlpha, template.Must(template.New(code.IsAlpha).Parse(message.IsAlpha)))
-	fallback.Store(code.IsAlphaNumeric, template.Must(template.New(code.IsAlphaNumeric).Parse(message.IsAlphaNumeric)))
-	fallback.Store(code.IsAlphaDash, template.Must(template.New(code.IsAlphaDash).Parse(message.IsAlphaDash)))
-	fallback.Store(code.IsAscii, template.Must(template.New(code.IsAscii).Parse(message.IsAscii)))
-	fallback.Store(code.IsAsciiNumeric, template.Must(template.New(code.IsAsciiNumeric).Parse(message.IsAsciiNumeric)))
-	fallback.Store(code.IsAsciiDash, template.Must(template.New(code.IsAsciiDash).Parse(message.IsAsciiDash)))
-	fallback.Store(code.IsNumber, template.Must(template.New(code.IsNumber).Parse(message.IsNumber)))
-	fallback.Store(code.IsPositiveNumber, template.Must(template.New(code.IsPositiveNumber).Parse(message.IsPositiveNumber)))
-	fallback.Store(code.IsNegativeNumber, template.Must(template.New(code.IsNegativeNumber).Parse(message.IsNegativeNumber)))
-	fallback.Store(code.IsInteger, template.Must(template.New(code.IsInteger).Parse(message.IsInteger)))
-	fallback.Store(code.IsPositiveInteger, template.Must(template.New(code.IsPositiveInteger).Parse(message.IsPositiveInteger)))
-	fallback.Store(code.IsNegativeInteger, template.Must(template.New(code.IsNegativeInteger).Parse(message.IsNegativeInteger)))
-	fallback.Store(code.IsBinary, template.Must(template.New(code.IsBinary).Parse(message.IsBinary)))
-	fallback.Store(code.IsOctal, template.Must(template.New(code.IsOctal).Parse(message.IsOctal)))
-	fallback.Store(code.IsHexadecimal, template.Must(template.New(code.IsHexadecimal).Parse(message.IsHexadecimal)))
-	fallback.Store(code.IsDecimal, template.Must(template.New(code.IsDecimal).Parse(message.IsDecimal)))
-
-	fallback.Store(code.IsIncludes, template.Must(template.New(code.IsIncludes).Parse(message.IsIncludes)))
-	fallback.Store(code.IsExcludes, template.Must(template.New(code.IsExcludes).Parse(message.IsExcludes)))
-	fallback.Store(code.IsUnique, template.Must(template.New(code.IsUnique).Parse(message.IsUnique)))
-	fallback.Store(code.IsCount, template.Must(template.New(code.IsCount).Parse(message.IsCount)))
-	fallback.Store(code.IsMinCount, template.Must(template.New(code.IsMinCount).Parse(message.IsMinCount)))
-	fallback.Store(code.IsMaxCount, template.Must(template.New(code.IsMaxCount).Parse(message.IsMaxCount)))
-
-	fallback.Store(code.IsContainsKey, template.Must(template.New(code.IsContainsKey).Parse(message.IsContainsKey)))
-	fallback.Store(code.IsNotContainsKey, template.Must(template.New(code.IsNotContainsKey).Parse(message.IsNotContainsKey)))
-
-	fallback.Store(code.IsTime, template.Must(template.New(code.IsTime).Parse(message.IsTime)))
-	fallback.Store(code.IsDuration, template.Must(template.New(code.IsDuration).Parse(message.IsDuration)))
-	fallback.Store(code.IsTimezone, template.Must(template.New(code.IsTimezone).Parse(message.IsTimezone)))
-	fallback.Store(code.IsBefore, template.Must(template.New(code.IsBefore).Parse(message.IsBefore)))
-	fallback.Store(code.IsBeforeOrEqualTo, template.Must(template.New(code.IsBeforeOrEqualTo).Parse(message.IsBeforeOrEqualTo)))
-	fallback.Store(code.IsAfter, template.Must(template.New(code.IsAfter).Parse(message.IsAfter)))
-	fallback.Store(code.IsAfterOrEqualTo, template.Must(template.New(code.IsAfterOrEqualTo).Parse(message.IsAfterOrEqualTo)))
-	fallback.Store(code.IsBeforeTZ, template.Must(template.New(code.IsBeforeTZ).Parse(message.IsBeforeTZ)))
-	fallback.Store(code.IsBeforeOrEqualToTZ, template.Must(template.New(code.IsBeforeOrEqualToTZ).Parse(message.IsBeforeOrEqualToTZ)))
-	fallback.Store(code.IsAfterTZ, template.Must(template.New(code.IsAfterTZ).Parse(message.IsAfterTZ)))
-	fallback.Store(code.IsAfterOrEqualToTZ, template.Must(template.New(code.IsAfterOrEqualToTZ).Parse(message.IsAfterOrEqualToTZ)))
-
-	fallback.Store(code.IsJSON, template.Must(template.New(code.IsJSON).Parse(message.IsJSON)))
-	fallback.Store(code.IsJSONArray, template.Must(template.New(code.IsJSONArray).Parse(message.IsJSONArray)))
-	fallback.Store(code.IsJSONObject, template.Must(template.New(code.IsJSONObject).Parse(message.IsJSONObject)))
-	fallback.Store(code.IsJSONString, template.Must(template.New(code.IsJSONString).Parse(message.IsJSONString)))
-	fallback.Store(code.IsUUID, template.Must(template.New(code.IsUUID).Parse(message.IsUUID)))
-	fallback.Store(code.IsUUIDV1, template.Must(template.New(code.IsUUIDV1).Parse(message.IsUUIDV1)))
-	fallback.Store(code.IsUUIDV2, template.Must(template.New(code.IsUUIDV2).Parse(message.IsUUIDV2)))
-	fallback.Store(code.IsUUIDV3, template.Must(template.New(code.IsUUIDV3).Parse(message.IsUUIDV3)))
-	fallback.Store(code.IsUUIDV4, template.Must(template.New(code.IsUUIDV4).Parse(message.IsUUIDV4)))
-	fallback.Store(code.IsUUIDV5, template.Must(template.New(code.IsUUIDV5).Parse(message.IsUUIDV5)))
-	fallback.Store(code.IsULID, template.Must(template.New(code.IsULID).Parse(message.IsULID)))
-	fallback.Store(code.IsBase64, template.Must(template.New(code.IsBase64).Parse(message.IsBase64)))
-	fallback.Store(code.IsBase32, template.Must(template.New(code.IsBase32).Parse(message.IsBase32)))
-
-	fallback.Store(code.IsIP, template.Must(template.New(code.IsIP).Parse(message.IsIP)))
-	fallback.Store(code.IsIPv4, template.Must(template.New(code.IsIPv4).Parse(message.IsIPv4)))
-	fallback.Store(code.IsIPv6, template.Must(template.New(code.IsIPv6).Parse(message.IsIPv6)))
-	fallback.Store(code.IsURL, template.Must(template.New(code.IsURL).Parse(message.IsURL)))
-	fallback.Store(code.IsURLWithScheme, template.Must(template.New(code.IsURLWithScheme).Parse(message.IsURLWithScheme)))
-	fallback.Store(code.IsRequestURI, template.Must(template.New(code.IsRequestURI).Parse(message.IsRequestURI)))
-	fallback.Store(code.IsURLQuery, template.Must(template.New(code.IsURLQuery).Parse(message.IsURLQuery)))
-
-	fallback.Store(code.IsEnum, template.Must(template.New(code.IsEnum).Parse(message.IsEnum)))
-	fallback.Store(code.IsEnumString, template.Must(template.New(code.IsEnumString).Parse(message.IsEnumString)))
-	fallback.Store(code.IsEnumValue, template.Must(template.New(code.IsEnumValue).Parse(message.IsEnumValue)))
-
-	fallback.Store(code.IsPathExists, template.Must(template.New(code.IsPathExists).Parse(message.IsPathExists)))
-	fallback.Store(code.IsPathNotExists, template.Must(template.New(code.IsPathNotExists).Parse(message.IsPathNotExists)))
-	fallback.Store(code.IsPathFile, template.Must(template.New(code.IsPathFile).Parse(message.IsPathFile)))
-	fallback.Store(code.IsPathDir, template.Must(template.New(code.IsPathDir).Parse(message.IsPathDir)))
-	fallback.Store(code.IsPathAbsolute, template.Must(template.New(code.IsPathAbsolute).Parse(message.IsPathAbsolute)))
-	fallback.Store(code.IsPathRelative, template.Must(template.New(code.IsPathRelative).Parse(message.IsPathRelative)))
+	fallback.Store(code.IsBlank, template.Must(newTemplate(code.IsBlank).Parse(message.IsBlank)))
+	fallback.Store(code.IsNotBlank, template.Must(newTemplate(code.IsNotBlank).Parse(message.IsNotBlank)))
+	fallback.Store(code.IsIn, template.Must(newTemplate(code.IsIn).Parse(message.IsIn)))
+	fallback.Store(code.IsNotIn, template.Must(newTemplate(code.IsNotIn).Parse(message.IsNotIn)))
+	fallback.Store(code.IsEqualTo, template.Must(newTemplate(code.IsEqualTo).Parse(message.IsEqualTo)))
+	fallback.Store(code.IsNotEqualTo, template.Must(newTemplate(code.IsNotEqualTo).Parse(message.IsNotEqualTo)))
+	fallback.Store(code.IsLessThan, template.Must(newTemplate(code.IsLessThan).Parse(message.IsLessThan)))
+	fallback.Store(code.IsLessThanOrEqualTo, template.Must(newTemplate(code.IsLessThanOrEqualTo).Parse(message.IsLessThanOrEqualTo)))
+	fallback.Store(code.IsGreaterThan, template.Must(newTemplate(code.IsGreaterThan).Parse(message.IsGreaterThan)))
+	fallback.Store(code.IsGreaterThanOrEqualTo, template.Must(newTemplate(code.IsGreaterThanOrEqualTo).Parse(message.IsGreaterThanOrEqualTo)))
+
+	fallback.Store(code.IsLength, template.Must(newTemplate(code.IsLength).Parse(message.IsLength)))
+	fallback.Store(code.IsMinLength, template.Must(newTemplate(code.IsMinLength).Parse(message.IsMinLength)))
+	fallback.Store(code.IsMaxLength, template.Must(newTemplate(code.IsMaxLength).Parse(message.IsMaxLength)))
+	fallback.Store(code.IsStartsWith, template.Must(newTemplate(code.IsStartsWith).Parse(message.IsStartsWith)))
+	fallback.Store(code.IsStartsWithAny, template.Must(newTemplate(code.IsStartsWithAny).Parse(message.IsStartsWithAny)))
+	fallback.Store(code.IsNotStartsWith, template.Must(newTemplate(code.IsNotStartsWith).Parse(message.IsNotStartsWith)))
+	fallback.Store(code.IsNotStartsWithAny, template.Must(newTemplate(code.IsNotStartsWithAny).Parse(message.IsNotStartsWithAny)))
+	fallback.Store(code.IsEndsWith, template.Must(newTemplate(code.IsEndsWith).Parse(message.IsEndsWith)))
+	fallback.Store(code.IsEndsWithAny, template.Must(newTemplate(code.IsEndsWithAny).Parse(message.IsEndsWithAny)))
+	fallback.Store(code.IsNotEndsWith, template.Must(newTemplate(code.IsNotEndsWith).Parse(message.IsNotEndsWith)))
+	fallback.Store(code.IsNotEndsWithAny, template.Must(newTemplate(code.IsNotEndsWithAny).Parse(message.IsNotEndsWithAny)))
+	fallback.Store(code.IsMatch, template.Must(newTemplate(code.IsMatch).Parse(message.IsMatch)))
+	fallback.Store(code.IsNotMatch, template.Must(newTemplate(code.IsNotMatch).Parse(message.IsNotMatch)))
+	fallback.Store(code.IsContains, template.Must(newTemplate(code.IsContains).Parse(message.IsContains)))
+	fallback.Store(code.IsNotContains, template.Must(newTemplate(code.IsNotContains).Parse(message.IsNotContains)))
+	fallback.Store(code.IsUpper, template.Must(newTemplate(code.IsUpper).Parse(message.IsUpper)))
+	fallback.Store(code.IsLower, template.Must(newTemplate(code.IsLower).Parse(message.IsLower)))
+	fallback.Store(code.IsAlpha, template.Must(newTemplate(code.IsAlpha).Parse(message.IsAlpha)))
+	fallback.Store(code.IsAlphaNumeric, template.Must(newTemplate(code.IsAlphaNumeric).Parse(message.IsAlphaNumeric)))
+	fallback.Store(code.IsAlphaDash, template.Must(newTemplate(code.IsAlphaDash).Parse(message.IsAlphaDash)))
+	fallback.Store(code.IsAscii, template.Must(newTemplate(code.IsAscii).Parse(message.IsAscii)))
+	fallback.Store(code.IsAsciiNumeric, template.Must(newTemplate(code.IsAsciiNumeric).Parse(message.IsAsciiNumeric)))
+	fallback.Store(code.IsAsciiDash, template.Must(newTemplate(code.IsAsciiDash).Parse(message.IsAsciiDash)))
+	fallback.Store(code.IsNumber, template.Must(newTemplate(code.IsNumber).Parse(message.IsNumber)))
+	fallback.Store(code.IsPositiveNumber, template.Must(newTemplate(code.IsPositiveNumber).Parse(message.IsPositiveNumber)))
+	fallback.Store(code.IsNegativeNumber, template.Must(newTemplate(code.IsNegativeNumber).Parse(message.IsNegativeNumber)))
+	fallback.Store(code.IsInteger, template.Must(newTemplate(code.IsInteger).Parse(message.IsInteger)))
+	fallback.Store(code.IsPositiveInteger, template.Must(newTemplate(code.IsPositiveInteger).Parse(message.IsPositiveInteger)))
+	fallback.Store(code.IsNegativeInteger, template.Must(newTemplate(code.IsNegativeInteger).Parse(message.IsNegativeInteger)))
+	fallback.Store(code.IsBinary, template.Must(newTemplate(code.IsBinary).Parse(message.IsBinary)))
+	fallback.Store(code.IsOctal, template.Must(newTemplate(code.IsOctal).Parse(message.IsOctal)))
+	fallback.Store(code.IsHexadecimal, template.Must(newTemplate(code.IsHexadecimal).Parse(message.IsHexadecimal)))
+	fallback.Store(code.IsDecimal, template.Must(newTemplate(code.IsDecimal).Parse(message.IsDecimal)))
+
+	fallback.Store(code.IsIncludes, template.Must(newTemplate(code.IsIncludes).Parse(message.IsIncludes)))
+	fallback.Store(code.IsExcludes, template.Must(newTemplate(code.IsExcludes).Parse(message.IsExcludes)))
+	fallback.Store(code.IsUnique, template.Must(newTemplate(code.IsUnique).Parse(message.IsUnique)))
+	fallback.Store(code.IsCount, template.Must(newTemplate(code.IsCount).Parse(message.IsCount)))
+	fallback.Store(code.IsMinCount, template.Must(newTemplate(code.IsMinCount).Parse(message.IsMinCount)))
+	fallback.Store(code.IsMaxCount, template.Must(newTemplate(code.IsMaxCount).Parse(message.IsMaxCount)))
+	fallback.Store(code.IsCount+".one", template.Must(newTemplate(code.IsCount).Parse(message.IsCountOne)))
+	fallback.Store(code.IsCount+".other", template.Must(newTemplate(code.IsCount).Parse(message.IsCountOther)))
+	fallback.Store(code.IsMinCount+".one", template.Must(newTemplate(code.IsMinCount).Parse(message.IsMinCountOne)))
+	fallback.Store(code.IsMinCount+".other", template.Must(newTemplate(code.IsMinCount).Parse(message.IsMinCountOther)))
+	fallback.Store(code.IsMaxCount+".one", template.Must(newTemplate(code.IsMaxCount).Parse(message.IsMaxCountOne)))
+	fallback.Store(code.IsMaxCount+".other", template.Must(newTemplate(code.IsMaxCount).Parse(message.IsMaxCountOther)))
+
+	fallback.Store(code.IsContainsKey, template.Must(newTemplate(code.IsContainsKey).Parse(message.IsContainsKey)))
+	fallback.Store(code.IsNotContainsKey, template.Must(newTemplate(code.IsNotContainsKey).Parse(message.IsNotContainsKey)))
+
+	fallback.Store(code.IsTime, template.Must(newTemplate(code.IsTime).Parse(message.IsTime)))
+	fallback.Store(code.IsDuration, template.Must(newTemplate(code.IsDuration).Parse(message.IsDuration)))
+	fallback.Store(code.IsTimezone, template.Must(newTemplate(code.IsTimezone).Parse(message.IsTimezone)))
+	fallback.Store(code.IsBefore, template.Must(newTemplate(code.IsBefore).Parse(message.IsBefore)))
+	fallback.Store(code.IsBeforeOrEqualTo, template.Must(newTemplate(code.IsBeforeOrEqualTo).Parse(message.IsBeforeOrEqualTo)))
+	fallback.Store(code.IsAfter, template.Must(newTemplate(code.IsAfter).Parse(message.IsAfter)))
+	fallback.Store(code.IsAfterOrEqualTo, template.Must(newTemplate(code.IsAfterOrEqualTo).Parse(message.IsAfterOrEqualTo)))
+	fallback.Store(code.IsBeforeTZ, template.Must(newTemplate(code.IsBeforeTZ).Parse(message.IsBeforeTZ)))
+	fallback.Store(code.IsBeforeOrEqualToTZ, template.Must(newTemplate(code.IsBeforeOrEqualToTZ).Parse(message.IsBeforeOrEqualToTZ)))
+	fallback.Store(code.IsAfterTZ, template.Must(newTemplate(code.IsAfterTZ).Parse(message.IsAfterTZ)))
+	fallback.Store(code.IsAfterOrEqualToTZ, template.Must(newTemplate(code.IsAfterOrEqualToTZ).Parse(message.IsAfterOrEqualToTZ)))
+
+	fallback.Store(code.IsJSON, template.Must(newTemplate(code.IsJSON).Parse(message.IsJSON)))
+	fallback.Store(code.IsJSONArray, template.Must(newTemplate(code.IsJSONArray).Parse(message.IsJSONArray)))
+	fallback.Store(code.IsJSONObject, template.Must(newTemplate(code.IsJSONObject).Parse(message.IsJSONObject)))
+	fallback.Store(code.IsJSONString, template.Must(newTemplate(code.IsJSONString).Parse(message.IsJSONString)))
+	fallback.Store(code.IsUUID, template.Must(newTemplate(code.IsUUID).Parse(message.IsUUID)))
+	fallback.Store(code.IsUUIDV1, template.Must(newTemplate(code.IsUUIDV1).Parse(message.IsUUIDV1)))
+	fallback.Store(code.IsUUIDV2, template.Must(newTemplate(code.IsUUIDV2).Parse(message.IsUUIDV2)))
+	fallback.Store(code.IsUUIDV3, template.Must(newTemplate(code.IsUUIDV3).Parse(message.IsUUIDV3)))
+	fallback.Store(code.IsUUIDV4, template.Must(newTemplate(code.IsUUIDV4).Parse(message.IsUUIDV4)))
+	fallback.Store(code.IsUUIDV5, template.Must(newTemplate(code.IsUUIDV5).Parse(message.IsUUIDV5)))
+	fallback.Store(code.IsULID, template.Must(newTemplate(code.IsULID).Parse(message.IsULID)))
+	fallback.Store(code.IsBase64, template.Must(newTemplate(code.IsBase64).Parse(message.IsBase64)))
+	fallback.Store(code.IsBase32, template.Must(newTemplate(code.IsBase32).Parse(message.IsBase32)))
+
+	fallback.Store(code.IsIP, template.Must(newTemplate(code.IsIP).Parse(message.IsIP)))
+	fallback.Store(code.IsIPv4, template.Must(newTemplate(code.IsIPv4).Parse(message.IsIPv4)))
+	fallback.Store(code.IsIPv6, template.Must(newTemplate(code.IsIPv6).Parse(message.IsIPv6)))
+	fallback.Store(code.IsURL, template.Must(newTemplate(code.IsURL).Parse(message.IsURL)))
+	fallback.Store(code.IsURLWithScheme, template.Must(newTemplate(code.IsURLWithScheme).Parse(message.IsURLWithScheme)))
+	fallback.Store(code.IsRequestURI, template.Must(newTemplate(code.IsRequestURI).Parse(message.IsRequestURI)))
+	fallback.Store(code.IsURLQuery, template.Must(newTemplate(code.IsURLQuery).Parse(message.IsURLQuery)))
+	fallback.Store(code.IsEmail, template.Must(newTemplate(code.IsEmail).Parse(message.IsEmail)))
+
+	fallback.Store(code.IsEnum, template.Must(newTemplate(code.IsEnum).Parse(message.IsEnum)))
+	fallback.Store(code.IsEnumString, template.Must(newTemplate(code.IsEnumString).Parse(message.IsEnumString)))
+	fallback.Store(code.IsEnumValue, template.Must(newTemplate(code.IsEnumValue).Parse(message.IsEnumValue)))
+
+	fallback.Store(code.IsPathExists, template.Must(newTemplate(code.IsPathExists).Parse(message.IsPathExists)))
+	fallback.Store(code.IsPathNotExists, template.Must(newTemplate(code.IsPathNotExists).Parse(message.IsPathNotExists)))
+	fallback.Store(code.IsPathFile, template.Must(newTemplate(code.IsPathFile).Parse(message.IsPathFile)))
+	fallback.Store(code.IsPathDir, template.Must(newTemplate(code.IsPathDir).Parse(message.IsPathDir)))
+	fallback.Store(code.IsPathAbsolute, template.Must(newTemplate(code.IsPathAbsolute).Parse(message.IsPathAbsolute)))
+	fallback.Store(code.IsPathRelative, template.Must(newTemplate(code.IsPathRelative).Parse(message.IsPathRelative)))
+
+	fallback.Store(code.IsAnyOf, template.Must(newTemplate(code.IsAnyOf).Parse(message.IsAnyOf)))
+	fallback.Store(code.IsAllOf, template.Must(newTemplate(code.IsAllOf).Parse(message.IsAllOf)))
+	fallback.Store(code.IsOneOf, template.Must(newTemplate(code.IsOneOf).Parse(message.IsOneOf)))
+
+	fallback.Store(code.IsSameAs, template.Must(newTemplate(code.IsSameAs).Parse(message.IsSameAs)))
+	fallback.Store(code.IsDifferentFrom, template.Must(newTemplate(code.IsDifferentFrom).Parse(message.IsDifferentFrom)))
+	fallback.Store(code.IsLessThanField, template.Must(newTemplate(code.IsLessThanField).Parse(message.IsLessThanField)))
+	fallback.Store(code.IsLessThanOrEqualToField, template.Must(newTemplate(code.IsLessThanOrEqualToField).Parse(message.IsLessThanOrEqualToField)))
+	fallback.Store(code.IsGreaterThanField, template.Must(newTemplate(code.IsGreaterThanField).Parse(message.IsGreaterThanField)))
+	fallback.Store(code.IsGreaterThanOrEqualToField, template.Must(newTemplate(code.IsGreaterThanOrEqualToField).Parse(message.IsGreaterThanOrEqualToField)))
+	fallback.Store(code.IsBeforeField, template.Must(newTemplate(code.IsBeforeField).Parse(message.IsBeforeField)))
+	fallback.Store(code.IsAfterField, template.Must(newTemplate(code.IsAfterField).Parse(message.IsAfterField)))
+
+	fallback.Store(code.IsRequiredIf, template.Must(newTemplate(code.IsRequiredIf).Parse(message.IsRequiredIf)))
+	fallback.Store(code.IsRequiredUnless, template.Must(newTemplate(code.IsRequiredUnless).Parse(message.IsRequiredUnless)))
+	fallback.Store(code.IsRequiredWith, template.Must(newTemplate(code.IsRequiredWith).Parse(message.IsRequiredWith)))
+	fallback.Store(code.IsRequiredWithAll, template.Must(newTemplate(code.IsRequiredWithAll).Parse(message.IsRequiredWithAll)))
+	fallback.Store(code.IsRequiredWithout, template.Must(newTemplate(code.IsRequiredWithout).Parse(message.IsRequiredWithout)))
+	fallback.Store(code.IsProhibitedIf, template.Must(newTemplate(code.IsProhibitedIf).Parse(message.IsProhibitedIf)))
 
 	translations.Store(fallbackLanguage, fallback)
 }
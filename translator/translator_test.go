@@ -0,0 +1,146 @@
+package translator
+
+import (
+	"sync"
+	"testing"
+	"text/template"
+
+	"github.com/gopi-frame/validation/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterTranslation(t *testing.T) {
+	RegisterTranslation("test-zh", map[string]string{
+		code.IsNotBlank: "{{.attribute}}不能为空。",
+	})
+	RegisterTranslation("test-de", map[string]string{
+		code.IsNotBlank: "{{.attribute}} darf nicht leer sein.",
+	})
+	params := map[string]any{"attribute": "name"}
+
+	assert.Equal(t, "name不能为空。", New().Locale("test-zh").T(code.IsNotBlank, params))
+	assert.Equal(t, "name darf nicht leer sein.", New().Locale("test-de").T(code.IsNotBlank, params))
+	assert.Equal(t, "name should not be blank.", New().T(code.IsNotBlank, params))
+	assert.Equal(t, "name should not be blank.", New().Locale("en").T(code.IsNotBlank, params))
+	assert.Equal(t, "name should not be blank.", New().Locale("unknown").T(code.IsNotBlank, params))
+	// messages missing in a language fall back to English
+	assert.Equal(t, "name should be blank.", New().Locale("test-de").T(code.IsBlank, params))
+}
+
+func TestRegisterTranslation_Merge(t *testing.T) {
+	RegisterTranslation("test-fr", map[string]string{
+		code.IsNotBlank: "{{.attribute}} ne doit pas être vide.",
+	})
+	RegisterTranslation("test-fr", map[string]string{
+		code.IsBlank: "{{.attribute}} doit être vide.",
+	})
+	params := map[string]any{"attribute": "nom"}
+	assert.Equal(t, "nom ne doit pas être vide.", New().Locale("test-fr").T(code.IsNotBlank, params))
+	assert.Equal(t, "nom doit être vide.", New().Locale("test-fr").T(code.IsBlank, params))
+}
+
+func TestTranslator_FallbackChain(t *testing.T) {
+	RegisterTranslation("test-zh-Hant", map[string]string{
+		code.IsNotBlank: "{{.attribute}}不能為空。",
+	})
+	RegisterTranslation("test-zh", map[string]string{
+		code.IsNotBlank: "{{.attribute}}不能为空。",
+		code.IsBlank:    "{{.attribute}}必须为空。",
+	})
+	params := map[string]any{"attribute": "name"}
+	tw := New().Locale("test-zh-Hant-TW")
+	assert.Equal(t, "name不能為空。", tw.T(code.IsNotBlank, params))
+	assert.Equal(t, "name必须为空。", tw.T(code.IsBlank, params))
+	assert.Equal(t, "name should be uppercase.", tw.T(code.IsUpper, params))
+	assert.Equal(t, "name不能為空。", New().Locale("test_zh_hant").T(code.IsNotBlank, params))
+	assert.Equal(t, []string{"zh-hant-tw", "zh-hant", "zh", "en"}, fallbackChain("zh-Hant-TW"))
+}
+
+func TestTranslator_Concurrency(t *testing.T) {
+	var wg sync.WaitGroup
+	params := map[string]any{"attribute": "name"}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterTranslation("test-ja", map[string]string{
+				code.IsNotBlank: "{{.attribute}}は空にできません。",
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			message := New().Locale("test-ja").T(code.IsNotBlank, params)
+			assert.Contains(t, []string{"name should not be blank.", "nameは空にできません。"}, message)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTranslator_Attribute(t *testing.T) {
+	RegisterTranslation("test-attribute", map[string]string{
+		"attribute.email": "E-Mail-Adresse",
+	})
+	assert.Equal(t, "E-Mail-Adresse", New().Locale("test-attribute").T("attribute.email", nil))
+	assert.Equal(t, "", New().Locale("test-attribute").T("attribute.email_address", nil))
+	assert.Equal(t, "", New().T("attribute.email", nil))
+}
+
+func TestTranslator_SafeRendering(t *testing.T) {
+	assert.Error(t, RegisterTranslation("test-safe", map[string]string{
+		code.IsBlank:    "{{.attribute}} ok",
+		code.IsNotBlank: "{{.attribute",
+	}))
+	// nothing is registered if any of the messages is malformed
+	assert.Equal(t, "name should be blank.", New().Locale("test-safe").T(code.IsBlank, map[string]any{"attribute": "name"}))
+
+	var reported []error
+	SetErrorHandler(func(err error) {
+		reported = append(reported, err)
+	})
+	defer SetErrorHandler(nil)
+	assert.NoError(t, RegisterTranslation("test-safe", map[string]string{
+		code.IsNotBlank: "{{.attribute.Name}} darf nicht leer sein.",
+	}))
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "", New().Locale("test-safe").T(code.IsNotBlank, map[string]any{"attribute": "name"}))
+		assert.Equal(t, "", New().Locale("test-safe").P(code.IsNotBlank, 1, map[string]any{"attribute": "name"}))
+	})
+	if assert.Len(t, reported, 2) {
+		assert.ErrorContains(t, reported[0], code.IsNotBlank)
+	}
+}
+
+func TestSetErrorHandler_Concurrent(t *testing.T) {
+	assert.NoError(t, RegisterTranslation("test-handler", map[string]string{
+		code.IsNotBlank: "{{.attribute.Name}} darf nicht leer sein.",
+	}))
+	defer SetErrorHandler(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SetErrorHandler(func(err error) {})
+		}()
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "", New().Locale("test-handler").T(code.IsNotBlank, map[string]any{"attribute": "name"}))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRegisterFuncs(t *testing.T) {
+	RegisterFuncs(template.FuncMap{
+		"initial": func(s string) string { return s[:1] },
+	})
+	assert.NoError(t, RegisterTranslation("test-funcs", map[string]string{
+		code.IsIn:       "{{initial .attribute}}: {{join \" | \" .values}}",
+		code.IsNotBlank: "{{upper .attribute}}",
+	}))
+	tr := New().Locale("test-funcs")
+	assert.Equal(t, "n: a | b", tr.T(code.IsIn, map[string]any{"attribute": "name", "values": []string{"a", "b"}}))
+	assert.Equal(t, "NAME", tr.T(code.IsNotBlank, map[string]any{"attribute": "name"}))
+	assert.Error(t, RegisterTranslation("test-funcs", map[string]string{code.IsBlank: "{{unknown .attribute}}"}))
+}
@@ -2,10 +2,15 @@ package validation
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"text/template"
 
 	"github.com/gopi-frame/contract/validation"
 	error2 "github.com/gopi-frame/validation/errpack"
 	"github.com/gopi-frame/validation/translator"
+	"github.com/gopi-frame/validation/validator"
 )
 
 type contextKey string
@@ -24,7 +29,25 @@ func LanguageFromContext(ctx context.Context) string {
 	return l
 }
 
+// BindPreferredLanguage binds the registered language best matching the preferred language tags to context,
+// the tags are in descending order of preference, see [translator.Match].
+// The context is returned as is if none of the tags matches, so that the default language is used.
+func BindPreferredLanguage(ctx context.Context, tags ...string) context.Context {
+	if language, ok := translator.Match(tags...); ok {
+		return BindLanguage(ctx, language)
+	}
+	return ctx
+}
+
+// BindAcceptLanguage binds the registered language best matching the Accept-Language header to context,
+// e.g. BindAcceptLanguage(r.Context(), r.Header.Get("Accept-Language")).
+func BindAcceptLanguage(ctx context.Context, header string) context.Context {
+	return BindPreferredLanguage(ctx, translator.ParseAcceptLanguage(header)...)
+}
+
+// validateContext collects the validators by key, keys are kept in the order they were added.
 type validateContext struct {
+	keys       []string
 	validators map[string][]validation.Validatable
 }
 
@@ -32,14 +55,55 @@ func (v *validateContext) AddValidate(key string, validator validation.Validatab
 	if v.validators == nil {
 		v.validators = make(map[string][]validation.Validatable)
 	}
+	if _, ok := v.validators[key]; !ok {
+		v.keys = append(v.keys, key)
+	}
 	v.validators[key] = append(v.validators[key], validator)
 }
 
+var defaultValidator atomic.Pointer[Validator]
+
+func init() {
+	v, _ := NewValidator()
+	defaultValidator.Store(v)
+}
+
+// SetDefaultValidator replaces the validator used by the package-level functions.
+// It is safe to call concurrently with validations, which use either the previous or the new validator.
+func SetDefaultValidator(v *Validator) {
+	defaultValidator.Store(v)
+}
+
+// Validate validates the given builders with the default validator.
+func Validate(ctx context.Context, builders ...validation.ValidatorBuilder) validation.ErrorBag {
+	return defaultValidator.Load().Validate(ctx, builders...)
+}
+
+// Value validates the given value using the given rules with the default validator.
+// The errors are stored under an empty key and have no attribute, their messages are about the "value" (see [error2.DefaultAttribute]),
+// so that they can be attached to an attribute later, e.g. via [error2.Bag.AddParam].
+func Value[T any](ctx context.Context, value T, rules ...validation.Rule[T]) validation.ErrorBag {
+	return defaultValidator.Load().Validate(ctx, Group("", value, rules...))
+}
+
+// Attribute validates the given validatable value as the given attribute with the default validator.
+func Attribute(ctx context.Context, attribute string, validatable validation.Validatable) validation.ErrorBag {
+	return defaultValidator.Load().Validate(ctx, NewBuilder(validatable).SetAttribute(attribute))
+}
+
 type Validator struct {
 	translator      validation.Translator
 	defaultLanguage string
 	errorBuilder    validation.ErrorBuilder
 	messages        map[string]string
+	customMessages  []customMessage
+	language        string
+	bail            bool
+	concurrency     int
+	attributeNames  map[string]string
+	errorHandler    func(err error)
+	funcs           template.FuncMap
+	templates       *error2.Templates
 }
 
 func NewValidator(options ...Option) (*Validator, error) {
@@ -50,6 +114,19 @@ func NewValidator(options ...Option) (*Validator, error) {
 			return nil, err
 		}
 	}
+	v.templates = error2.NewTemplates(v.funcs)
+	for c, message := range v.messages {
+		if _, err := v.templates.Parse(message); err != nil {
+			return nil, fmt.Errorf("validation: invalid message of %s: %w", c, err)
+		}
+	}
+	for _, m := range v.customMessages {
+		for c, message := range m.messages {
+			if _, err := v.templates.Parse(message); err != nil {
+				return nil, fmt.Errorf("validation: invalid message of %s for %s: %w", c, m.pattern, err)
+			}
+		}
+	}
 	return v, nil
 }
 
@@ -59,9 +136,19 @@ func (v *Validator) clone() *Validator {
 		defaultLanguage: v.defaultLanguage,
 		errorBuilder:    v.errorBuilder,
 		messages:        v.messages,
+		customMessages:  v.customMessages,
+		bail:            v.bail,
+		concurrency:     v.concurrency,
+		attributeNames:  v.attributeNames,
+		errorHandler:    v.errorHandler,
+		funcs:           v.funcs,
+		templates:       v.templates,
 	}
 }
 
+// Validate validates the given builders.
+// It stops when the context is done, the returned bag then only contains the errors collected so far,
+// it fails and matches the context error, e.g. errors.Is(bag, context.Canceled), see [error2.Bag.Err].
 func (v *Validator) Validate(ctx context.Context, builders ...validation.ValidatorBuilder) validation.ErrorBag {
 	validatorCtx := new(validateContext)
 	for _, builder := range builders {
@@ -69,27 +156,104 @@ func (v *Validator) Validate(ctx context.Context, builders ...validation.Validat
 	}
 	v2 := v.clone()
 	if language := LanguageFromContext(ctx); language != "" {
+		v2.language = language
 		v2.translator = v2.translator.Locale(language)
 	} else if v2.defaultLanguage != "" {
+		v2.language = v2.defaultLanguage
 		v2.translator = v2.translator.Locale(v.defaultLanguage)
 	}
-	bag := error2.NewBag()
-	for key, validators := range validatorCtx.validators {
-		for _, v := range validators {
-			if err := v.Validate(ctx, v2); err != nil {
-				if message, ok := v2.messages[err.Code()]; ok {
-					err = err.SetMessage(message)
+	if !v.isDefaultLanguage(v2.language) {
+		v2.attributeNames = nil
+	}
+	if overrides := AttributeNamesFromContext(ctx); overrides != nil || v2.attributeNames != nil {
+		v2.translator = &attributeTranslator{Translator: v2.translator, overrides: overrides, names: v2.attributeNames}
+	}
+	if v2.bail {
+		ctx = validator.BindBail(ctx)
+	}
+	results := make([][]validation.Error, len(validatorCtx.keys))
+	interrupted := false
+	if v2.concurrency > 1 {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, v2.concurrency)
+	dispatch:
+		for i, key := range validatorCtx.keys {
+			select {
+			case <-ctx.Done():
+				mu.Lock()
+				interrupted = true
+				mu.Unlock()
+				break dispatch
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(i int, validators []validation.Validatable) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				errs, ok := v2.validateKey(ctx, validators)
+				results[i] = errs
+				if !ok {
+					mu.Lock()
+					interrupted = true
+					mu.Unlock()
 				}
-				bag.AddError(key, err)
+			}(i, validatorCtx.validators[key])
+		}
+		wg.Wait()
+	} else {
+		for i, key := range validatorCtx.keys {
+			errs, ok := v2.validateKey(ctx, validatorCtx.validators[key])
+			results[i] = errs
+			if !ok {
+				interrupted = true
+				break
 			}
 		}
 	}
+	// results are merged in the order of keys, so that the output is the same regardless of the concurrency.
+	bag := error2.NewBag()
+	for i, key := range validatorCtx.keys {
+		for _, err := range results[i] {
+			bag.AddError(key, err)
+		}
+	}
+	bag = v2.applyCustomMessages(v2.language, bag)
+	if interrupted {
+		bag.SetErr(ctx.Err())
+	}
 	return bag
 }
 
+// validateKey runs the validators of a key, it returns false if the context is done before all validators run.
+func (v *Validator) validateKey(ctx context.Context, validators []validation.Validatable) ([]validation.Error, bool) {
+	var errs []validation.Error
+	for _, validatable := range validators {
+		if ctx.Err() != nil {
+			return errs, false
+		}
+		if err := validatable.Validate(ctx, v); err != nil {
+			if message, ok := v.messages[err.Code()]; ok {
+				err = err.SetMessage(message)
+			}
+			errs = append(errs, err)
+			if error2.SeverityOf(err) != error2.SeverityError {
+				continue
+			}
+			if _, ok := validatable.(bailValidatable); ok || v.bail {
+				break
+			}
+		}
+	}
+	return errs, true
+}
+
 func (v *Validator) BuildError(code string, message string, params ...validation.Param) validation.Error {
 	if v.errorBuilder != nil {
 		return v.errorBuilder.BuildError(code, message, params...)
 	}
-	return error2.NewError(code, message, params...).SetTranslator(v.translator)
+	return error2.NewError(code, message, params...).
+		SetTranslator(v.translator).
+		SetErrorHandler(v.errorHandler).
+		SetTemplates(v.templates)
 }
@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/gopi-frame/contract/validation"
+)
+
+type bailKey struct{}
+
+// BindBail binds the bail mode to context.
+// In bail mode, [Group] stops evaluating the remaining rules after the first error.
+func BindBail(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bailKey{}, true)
+}
+
+// BailFromContext reports whether the bail mode is bound to context.
+func BailFromContext(ctx context.Context) bool {
+	bail, _ := ctx.Value(bailKey{}).(bool)
+	return bail
+}
+
+// Bail returns a validator builder that validates the given value using the given rules,
+// and stops at the first error.
+// if the value is an implementation of Validatable, it will be validated first before the rules.
+func Bail[T any](rules ...validation.Rule[T]) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		return Group(rules...).Validate(BindBail(ctx), builder, value)
+	}
+}
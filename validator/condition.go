@@ -15,3 +15,23 @@ func If[T any](condition bool, rules ...validation.Rule[T]) RuleFunc[T] {
 		return nil
 	}
 }
+
+// When returns a validator builder that validates the given value using the given rules when the predicate returns true.
+// Unlike [If], the predicate is evaluated at validation time against the value being validated.
+// if the value is an implementation of the [validation.Validatable] interface, it will be validated first before the rules.
+func When[T any](predicate func(ctx context.Context, value T) bool, rules ...validation.Rule[T]) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if predicate(ctx, value) {
+			return Group(rules...).Validate(ctx, builder, value)
+		}
+		return nil
+	}
+}
+
+// Unless returns a validator builder that validates the given value using the given rules when the predicate returns false.
+// if the value is an implementation of the [validation.Validatable] interface, it will be validated first before the rules.
+func Unless[T any](predicate func(ctx context.Context, value T) bool, rules ...validation.Rule[T]) RuleFunc[T] {
+	return When(func(ctx context.Context, value T) bool {
+		return !predicate(ctx, value)
+	}, rules...)
+}
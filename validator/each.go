@@ -17,7 +17,7 @@ func Each[T any](rules ...validation.Rule[T]) RuleFunc[[]T] {
 				bag.AddError(strconv.Itoa(index), err)
 			}
 		}
-		if bag.Fails() {
+		if bag.Fails() || bag.HasWarnings() {
 			return bag
 		}
 		return nil
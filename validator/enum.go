@@ -8,6 +8,7 @@ import (
 	"github.com/gopi-frame/contract/enum"
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/code"
+	error2 "github.com/gopi-frame/validation/errpack"
 )
 
 func IsEnum[T enum.Enum]() RuleFunc[T] {
@@ -27,10 +28,10 @@ func IsEnumString[T enum.Enum]() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, value string) validation.Error {
 		var dummy T
 		if _, err := dummy.Parse(value); err != nil {
-			return builder.BuildError(
+			return error2.WithCause(builder.BuildError(
 				code.IsEnumString,
 				message.IsEnumString,
-			)
+			), err)
 		}
 		return nil
 	}
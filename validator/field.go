@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/code"
+	error2 "github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/message"
+)
+
+// IsSameAs validates the value is equal to the value of the other attribute.
+// The name of the other attribute is reported as the "other" param, which is translated like the "attribute" param.
+func IsSameAs[T comparable](otherAttribute string, other T) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if value != other {
+			return builder.BuildError(code.IsSameAs, message.IsSameAs, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsDifferentFrom validates the value is not equal to the value of the other attribute.
+func IsDifferentFrom[T comparable](otherAttribute string, other T) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if value == other {
+			return builder.BuildError(code.IsDifferentFrom, message.IsDifferentFrom, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsLessThanField validates the value is less than the value of the other attribute.
+func IsLessThanField[T cmp.Ordered](otherAttribute string, other T) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if value >= other {
+			return builder.BuildError(code.IsLessThanField, message.IsLessThanField, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsLessThanOrEqualToField validates the value is less than or equal to the value of the other attribute.
+func IsLessThanOrEqualToField[T cmp.Ordered](otherAttribute string, other T) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if value > other {
+			return builder.BuildError(code.IsLessThanOrEqualToField, message.IsLessThanOrEqualToField, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsGreaterThanField validates the value is greater than the value of the other attribute.
+func IsGreaterThanField[T cmp.Ordered](otherAttribute string, other T) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if value <= other {
+			return builder.BuildError(code.IsGreaterThanField, message.IsGreaterThanField, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsGreaterThanOrEqualToField validates the value is greater than or equal to the value of the other attribute.
+func IsGreaterThanOrEqualToField[T cmp.Ordered](otherAttribute string, other T) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if value < other {
+			return builder.BuildError(code.IsGreaterThanOrEqualToField, message.IsGreaterThanOrEqualToField, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsBeforeField validates the time is before the time of the other attribute.
+func IsBeforeField(otherAttribute string, other time.Time) RuleFunc[time.Time] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value time.Time) validation.Error {
+		if !value.Before(other) {
+			return builder.BuildError(code.IsBeforeField, message.IsBeforeField, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
+
+// IsAfterField validates the time is after the time of the other attribute.
+func IsAfterField(otherAttribute string, other time.Time) RuleFunc[time.Time] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value time.Time) validation.Error {
+		if !value.After(other) {
+			return builder.BuildError(code.IsAfterField, message.IsAfterField, error2.NewParam("other", otherAttribute))
+		}
+		return nil
+	}
+}
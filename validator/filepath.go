@@ -16,11 +16,11 @@ import (
 func IsPathExists() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, value string) validation.Error {
 		if _, err := os.Stat(value); err != nil {
-			return builder.BuildError(
+			return errpack.WithCause(builder.BuildError(
 				code.IsPathExists,
 				message.IsPathExists,
 				errpack.NewParam("value", value),
-			)
+			), err)
 		}
 		return nil
 	}
@@ -42,11 +42,11 @@ func IsPathNotExists() StringRuleFunc {
 func IsPathFile() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, value string) validation.Error {
 		if info, err := os.Stat(value); err != nil {
-			return builder.BuildError(
+			return errpack.WithCause(builder.BuildError(
 				code.IsPathFile,
 				message.IsPathFile,
 				errpack.NewParam("value", value),
-			)
+			), err)
 		} else if info.IsDir() {
 			return builder.BuildError(
 				code.IsPathFile,
@@ -61,11 +61,11 @@ func IsPathFile() StringRuleFunc {
 func IsPathDir() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, value string) validation.Error {
 		if info, err := os.Stat(value); err != nil {
-			return builder.BuildError(
+			return errpack.WithCause(builder.BuildError(
 				code.IsPathDir,
 				message.IsPathDir,
 				errpack.NewParam("value", value),
-			)
+			), err)
 		} else if !info.IsDir() {
 			return builder.BuildError(
 				code.IsPathDir,
@@ -9,20 +9,28 @@ import (
 
 // Group returns a validator builder that validates the given value using the given rules.
 // if the value is an implementation of Validatable, it will be validated first before the rules.
+// If the bail mode is bound to the context, it stops at the first error, warnings excluded, see [BindBail].
 func Group[T any](rules ...validation.Rule[T]) RuleFunc[T] {
 	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
 		var bag = error2.NewBag()
+		var bail = BailFromContext(ctx)
 		if v, ok := any(value).(validation.Validatable); ok {
 			if err := v.Validate(ctx, builder); err != nil {
 				bag.AddError("", err)
+				if bail && error2.SeverityOf(err) == error2.SeverityError {
+					return bag
+				}
 			}
 		}
 		for _, rule := range rules {
 			if err := rule.Validate(ctx, builder, value); err != nil {
 				bag.AddError("", err)
+				if bail && error2.SeverityOf(err) == error2.SeverityError {
+					break
+				}
 			}
 		}
-		if bag.Fails() {
+		if bag.Fails() || bag.HasWarnings() {
 			return bag
 		}
 		return nil
@@ -0,0 +1,105 @@
+package validator
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/code"
+	error2 "github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/message"
+)
+
+// AnyOf returns a validator builder that validates the given value passes at least one of the given rules.
+// The errors of the rules are attached as the "errors" param when all of them fail.
+// Only errors of [error2.SeverityError] fail a rule, warnings and notices are ignored.
+func AnyOf[T any](rules ...validation.Rule[T]) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		var errs []validation.Error
+		for _, rule := range rules {
+			err := rule.Validate(ctx, builder, value)
+			if !fails(err) {
+				return nil
+			}
+			errs = append(errs, flatten(err)...)
+		}
+		return builder.BuildError(code.IsAnyOf, message.IsAnyOf, error2.NewErrorsParam("errors", errs...))
+	}
+}
+
+// AllOf returns a validator builder that validates the given value passes all the given rules.
+// Unlike [Group], the failures are reported as a single error, with the errors of the rules attached as the "errors" param.
+// Only errors of [error2.SeverityError] fail a rule, warnings and notices are ignored.
+func AllOf[T any](rules ...validation.Rule[T]) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		var errs []validation.Error
+		for _, rule := range rules {
+			if err := rule.Validate(ctx, builder, value); fails(err) {
+				errs = append(errs, flatten(err)...)
+			}
+		}
+		if len(errs) > 0 {
+			return builder.BuildError(code.IsAllOf, message.IsAllOf, error2.NewErrorsParam("errors", errs...))
+		}
+		return nil
+	}
+}
+
+// OneOf returns a validator builder that validates the given value passes exactly one of the given rules.
+// The errors of the failed rules are attached as the "errors" param, and the number of passed rules as the "passed" param.
+// Only errors of [error2.SeverityError] fail a rule, warnings and notices are ignored.
+func OneOf[T any](rules ...validation.Rule[T]) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		var errs []validation.Error
+		var passed int
+		for _, rule := range rules {
+			if err := rule.Validate(ctx, builder, value); fails(err) {
+				errs = append(errs, flatten(err)...)
+			} else {
+				passed++
+			}
+		}
+		if passed != 1 {
+			return builder.BuildError(
+				code.IsOneOf,
+				message.IsOneOf,
+				error2.NewErrorsParam("errors", errs...),
+				error2.NewParam("passed", passed),
+			)
+		}
+		return nil
+	}
+}
+
+// Not returns a validator builder that validates the given value does not pass the given rule.
+// The error is built with the given code and message, a rule returning only warnings or notices passes.
+func Not[T any](rule validation.Rule[T], code string, message string) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if err := rule.Validate(ctx, builder, value); !fails(err) {
+			return builder.BuildError(code, message)
+		}
+		return nil
+	}
+}
+
+// fails reports whether the error fails the validation, see [error2.SeverityOf].
+func fails(err validation.Error) bool {
+	return err != nil && error2.SeverityOf(err) == error2.SeverityError
+}
+
+// flatten returns the errors of the given error bag, or the given error itself if it is not a bag.
+func flatten(err validation.Error) []validation.Error {
+	var bag validation.ErrorBag
+	if !errors.As(err, &bag) {
+		return []validation.Error{err}
+	}
+	var errs []validation.Error
+	bag.Each(func(_ string, es validation.Errors) bool {
+		es.Each(func(_ string, err validation.Error) bool {
+			errs = append(errs, err)
+			return true
+		})
+		return true
+	})
+	return errs
+}
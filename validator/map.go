@@ -2,8 +2,6 @@ package validator
 
 import (
 	"context"
-	"fmt"
-	"strconv"
 
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/code"
@@ -17,7 +15,7 @@ func IsContainsKey[K comparable, V any](key K) MapRuleFunc[K, V] {
 			return builder.BuildError(
 				code.IsContainsKey,
 				message.IsContainsKey,
-				error2.NewParam("key", strconv.Quote(fmt.Sprintf("%v", key))),
+				error2.NewParam("key", key),
 			)
 		}
 		return nil
@@ -30,7 +28,7 @@ func IsNotContainsKey[K comparable, V any](key K) MapRuleFunc[K, V] {
 			return builder.BuildError(
 				code.IsNotContainsKey,
 				message.IsNotContainsKey,
-				error2.NewParam("key", strconv.Quote(fmt.Sprintf("%v", key))),
+				error2.NewParam("key", key),
 			)
 		}
 		return nil
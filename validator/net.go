@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/code"
@@ -39,8 +40,8 @@ func IsIP6() StringRuleFunc {
 
 func IsURL() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.URL(s) {
-			return builder.BuildError(code.IsURL, message.IsURL)
+		if _, err := url.Parse(s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsURL, message.IsURL), err)
 		}
 		return nil
 	}
@@ -48,7 +49,9 @@ func IsURL() StringRuleFunc {
 
 func IsURLWithScheme(scheme string) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.URLWithScheme(s, scheme) {
+		if u, err := url.Parse(s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsURLWithScheme, message.IsURLWithScheme, error2.NewParam("scheme", scheme)), err)
+		} else if u.Scheme != scheme {
 			return builder.BuildError(code.IsURLWithScheme, message.IsURLWithScheme, error2.NewParam("scheme", scheme))
 		}
 		return nil
@@ -57,8 +60,8 @@ func IsURLWithScheme(scheme string) StringRuleFunc {
 
 func IsRequestURI() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.RequestURI(s) {
-			return builder.BuildError(code.IsRequestURI, message.IsRequestURI)
+		if _, err := url.ParseRequestURI(s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsRequestURI, message.IsRequestURI), err)
 		}
 		return nil
 	}
@@ -66,8 +69,17 @@ func IsRequestURI() StringRuleFunc {
 
 func IsURLQuery() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.URLQuery(s) {
-			return builder.BuildError(code.IsURLQuery, message.IsURLQuery)
+		if _, err := url.ParseQuery(s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsURLQuery, message.IsURLQuery), err)
+		}
+		return nil
+	}
+}
+
+func IsEmail() StringRuleFunc {
+	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
+		if !is.Email(s) {
+			return builder.BuildError(code.IsEmail, message.IsEmail)
 		}
 		return nil
 	}
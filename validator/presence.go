@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/gopi-frame/contract/validation"
+	"github.com/gopi-frame/validation/code"
+	error2 "github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/is"
+	"github.com/gopi-frame/validation/message"
+)
+
+// Field is another attribute and its value, which a presence rule depends on.
+type Field struct {
+	Attribute string
+	Value     any
+}
+
+// NewField returns a field with the given attribute and value.
+func NewField(attribute string, value any) Field {
+	return Field{Attribute: attribute, Value: value}
+}
+
+// IsRequiredIf validates the value is not blank when the value of the other attribute is one of the given values.
+func IsRequiredIf[T comparable, O comparable](otherAttribute string, other O, values ...O) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if !contains(values, other) {
+			return nil
+		}
+		if err := IsNotBlank[T]().Validate(ctx, builder, value); err != nil {
+			return builder.BuildError(
+				code.IsRequiredIf,
+				message.IsRequiredIf,
+				error2.NewParam("other", otherAttribute),
+				error2.NewParam("values", values),
+			)
+		}
+		return nil
+	}
+}
+
+// IsRequiredUnless validates the value is not blank unless the value of the other attribute is one of the given values.
+func IsRequiredUnless[T comparable, O comparable](otherAttribute string, other O, values ...O) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if contains(values, other) {
+			return nil
+		}
+		if err := IsNotBlank[T]().Validate(ctx, builder, value); err != nil {
+			return builder.BuildError(
+				code.IsRequiredUnless,
+				message.IsRequiredUnless,
+				error2.NewParam("other", otherAttribute),
+				error2.NewParam("values", values),
+			)
+		}
+		return nil
+	}
+}
+
+// IsRequiredWith validates the value is not blank when any of the other fields is not blank, see [Field].
+// The value may be of any type, e.g. a slice, which is blank if empty.
+func IsRequiredWith[T any](others ...Field) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		for _, other := range others {
+			if !is.Blank(other.Value) {
+				if is.Blank(value) {
+					return builder.BuildError(code.IsRequiredWith, message.IsRequiredWith, error2.NewParam("others", attributes(others)))
+				}
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+// IsRequiredWithAll validates the value is not blank when all the other fields are not blank.
+func IsRequiredWithAll[T any](others ...Field) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		for _, other := range others {
+			if is.Blank(other.Value) {
+				return nil
+			}
+		}
+		if is.Blank(value) {
+			return builder.BuildError(code.IsRequiredWithAll, message.IsRequiredWithAll, error2.NewParam("others", attributes(others)))
+		}
+		return nil
+	}
+}
+
+// IsRequiredWithout validates the value is not blank when any of the other fields is blank.
+func IsRequiredWithout[T any](others ...Field) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		for _, other := range others {
+			if is.Blank(other.Value) {
+				if is.Blank(value) {
+					return builder.BuildError(code.IsRequiredWithout, message.IsRequiredWithout, error2.NewParam("others", attributes(others)))
+				}
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+// IsProhibitedIf validates the value is blank when the value of the other attribute is one of the given values.
+func IsProhibitedIf[T comparable, O comparable](otherAttribute string, other O, values ...O) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if !contains(values, other) {
+			return nil
+		}
+		if err := IsBlank[T]().Validate(ctx, builder, value); err != nil {
+			return builder.BuildError(
+				code.IsProhibitedIf,
+				message.IsProhibitedIf,
+				error2.NewParam("other", otherAttribute),
+				error2.NewParam("values", values),
+			)
+		}
+		return nil
+	}
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// attributes returns the attributes of the fields, which are translated like the attribute of the error when rendered.
+func attributes(fields []Field) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Attribute)
+	}
+	return names
+}
@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/gopi-frame/contract/validation"
+	error2 "github.com/gopi-frame/validation/errpack"
+)
+
+// Warn returns a validator builder that validates the given value using the given rules,
+// and reports their errors as warnings, which don't fail the validation, see [error2.SeverityWarning].
+func Warn[T any](rules ...validation.Rule[T]) RuleFunc[T] {
+	return withSeverity(error2.SeverityWarning, rules...)
+}
+
+// Notice returns a validator builder that validates the given value using the given rules,
+// and reports their errors as notices, which don't fail the validation, see [error2.SeverityNotice].
+func Notice[T any](rules ...validation.Rule[T]) RuleFunc[T] {
+	return withSeverity(error2.SeverityNotice, rules...)
+}
+
+func withSeverity[T any](severity error2.Severity, rules ...validation.Rule[T]) RuleFunc[T] {
+	return func(ctx context.Context, builder validation.ErrorBuilder, value T) validation.Error {
+		if err := Group(rules...).Validate(ctx, builder, value); err != nil {
+			return error2.WithSeverity(err, severity)
+		}
+		return nil
+	}
+}
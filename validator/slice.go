@@ -2,9 +2,7 @@ package validator
 
 import (
 	"context"
-	"fmt"
 	"slices"
-	"strings"
 
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/code"
@@ -14,16 +12,12 @@ import (
 
 func IsIncludes[T comparable](elements ...T) SliceRuleFunc[T] {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s []T) validation.Error {
-		var includes []string
-		for _, element := range elements {
-			includes = append(includes, fmt.Sprintf("\"%v\"", element))
-		}
 		for _, e := range elements {
 			if !slices.Contains(s, e) {
 				return builder.BuildError(
 					code.IsIncludes,
 					message.IsIncludes,
-					error2.NewParam("values", strings.Join(includes, ", ")),
+					error2.NewParam("values", elements),
 				)
 			}
 		}
@@ -33,16 +27,12 @@ func IsIncludes[T comparable](elements ...T) SliceRuleFunc[T] {
 
 func IsExcludes[T comparable](elements ...T) SliceRuleFunc[T] {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s []T) validation.Error {
-		var excludes []string
-		for _, element := range elements {
-			excludes = append(excludes, fmt.Sprintf("\"%v\"", element))
-		}
 		for _, e := range elements {
 			if slices.Contains(s, e) {
 				return builder.BuildError(
 					code.IsExcludes,
 					message.IsExcludes,
-					error2.NewParam("values", strings.Join(excludes, ", ")),
+					error2.NewParam("values", elements),
 				)
 			}
 		}
@@ -66,7 +56,7 @@ func IsUnique[T comparable]() SliceRuleFunc[T] {
 func IsCount[T comparable](count int) SliceRuleFunc[T] {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s []T) validation.Error {
 		if len(s) != count {
-			return builder.BuildError(code.IsCount, message.IsCount, error2.NewParam("count", count))
+			return builder.BuildError(code.IsCount, message.IsCount, error2.NewCountParam("count", count))
 		}
 		return nil
 	}
@@ -75,7 +65,7 @@ func IsCount[T comparable](count int) SliceRuleFunc[T] {
 func IsMinCount[T comparable](count int) SliceRuleFunc[T] {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s []T) validation.Error {
 		if len(s) < count {
-			return builder.BuildError(code.IsMinCount, message.IsMinCount, error2.NewParam("count", count))
+			return builder.BuildError(code.IsMinCount, message.IsMinCount, error2.NewCountParam("count", count))
 		}
 		return nil
 	}
@@ -84,7 +74,7 @@ func IsMinCount[T comparable](count int) SliceRuleFunc[T] {
 func IsMaxCount[T comparable](count int) SliceRuleFunc[T] {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s []T) validation.Error {
 		if len(s) > count {
-			return builder.BuildError(code.IsMaxCount, message.IsMaxCount, error2.NewParam("count", count))
+			return builder.BuildError(code.IsMaxCount, message.IsMaxCount, error2.NewCountParam("count", count))
 		}
 		return nil
 	}
@@ -3,7 +3,6 @@ package validator
 import (
 	"context"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/gopi-frame/contract/validation"
@@ -16,7 +15,7 @@ import (
 func IsLength(length int) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if len(value) != length {
-			return errorBuilder.BuildError(code.IsLength, message.IsLength, error2.NewParam("length", strconv.Itoa(length)))
+			return errorBuilder.BuildError(code.IsLength, message.IsLength, error2.NewCountParam("length", length))
 		}
 		return nil
 	}
@@ -25,7 +24,7 @@ func IsLength(length int) StringRuleFunc {
 func IsMinLength(length int) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if len(value) < length {
-			return errorBuilder.BuildError(code.IsMinLength, message.IsMinLength, error2.NewParam("min", strconv.Itoa(length)))
+			return errorBuilder.BuildError(code.IsMinLength, message.IsMinLength, error2.NewCountParam("min", length))
 		}
 		return nil
 	}
@@ -34,7 +33,7 @@ func IsMinLength(length int) StringRuleFunc {
 func IsMaxLength(length int) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if len(value) > length {
-			return errorBuilder.BuildError(code.IsMaxLength, message.IsMaxLength, error2.NewParam("max", strconv.Itoa(length)))
+			return errorBuilder.BuildError(code.IsMaxLength, message.IsMaxLength, error2.NewCountParam("max", length))
 		}
 		return nil
 	}
@@ -43,7 +42,7 @@ func IsMaxLength(length int) StringRuleFunc {
 func IsStartsWith(prefix string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if !strings.HasPrefix(value, prefix) {
-			return errorBuilder.BuildError(code.IsStartsWith, message.IsStartsWith, error2.NewParam("prefix", strconv.Quote(prefix)))
+			return errorBuilder.BuildError(code.IsStartsWith, message.IsStartsWith, error2.NewParam("prefix", prefix))
 		}
 		return nil
 	}
@@ -56,18 +55,14 @@ func IsStartsWithAny(prefixes ...string) StringRuleFunc {
 				return nil
 			}
 		}
-		var ps []string
-		for _, prefix := range prefixes {
-			ps = append(ps, strconv.Quote(prefix))
-		}
-		return errorBuilder.BuildError(code.IsStartsWithAny, message.IsStartsWithAny, error2.NewParam("prefixes", strings.Join(ps, ", ")))
+		return errorBuilder.BuildError(code.IsStartsWithAny, message.IsStartsWithAny, error2.NewParam("prefixes", prefixes))
 	}
 }
 
 func IsEndsWith(suffix string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if !strings.HasSuffix(value, suffix) {
-			return errorBuilder.BuildError(code.IsEndsWith, message.IsEndsWith, error2.NewParam("suffix", strconv.Quote(suffix)))
+			return errorBuilder.BuildError(code.IsEndsWith, message.IsEndsWith, error2.NewParam("suffix", suffix))
 		}
 		return nil
 	}
@@ -80,18 +75,14 @@ func IsEndsWithAny(suffixes ...string) StringRuleFunc {
 				return nil
 			}
 		}
-		var ss []string
-		for _, suffix := range suffixes {
-			ss = append(ss, strconv.Quote(suffix))
-		}
-		return errorBuilder.BuildError(code.IsEndsWithAny, message.IsEndsWithAny, error2.NewParam("suffixes", strings.Join(ss, ", ")))
+		return errorBuilder.BuildError(code.IsEndsWithAny, message.IsEndsWithAny, error2.NewParam("suffixes", suffixes))
 	}
 }
 
 func IsNotStartsWith(prefix string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if strings.HasPrefix(value, prefix) {
-			return errorBuilder.BuildError(code.IsNotStartsWith, message.IsNotStartsWith, error2.NewParam("prefix", strconv.Quote(prefix)))
+			return errorBuilder.BuildError(code.IsNotStartsWith, message.IsNotStartsWith, error2.NewParam("prefix", prefix))
 		}
 		return nil
 	}
@@ -101,11 +92,7 @@ func IsNotStartsWithAny(prefixes ...string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		for _, prefix := range prefixes {
 			if strings.HasPrefix(value, prefix) {
-				var ps []string
-				for _, prefix := range prefixes {
-					ps = append(ps, strconv.Quote(prefix))
-				}
-				return errorBuilder.BuildError(code.IsNotStartsWithAny, message.IsNotStartsWithAny, error2.NewParam("prefixes", strings.Join(ps, ", ")))
+				return errorBuilder.BuildError(code.IsNotStartsWithAny, message.IsNotStartsWithAny, error2.NewParam("prefixes", prefixes))
 			}
 		}
 		return nil
@@ -115,7 +102,7 @@ func IsNotStartsWithAny(prefixes ...string) StringRuleFunc {
 func IsNotEndsWith(suffix string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if strings.HasSuffix(value, suffix) {
-			return errorBuilder.BuildError(code.IsNotEndsWith, message.IsNotEndsWith, error2.NewParam("suffix", strconv.Quote(suffix)))
+			return errorBuilder.BuildError(code.IsNotEndsWith, message.IsNotEndsWith, error2.NewParam("suffix", suffix))
 		}
 		return nil
 	}
@@ -125,11 +112,7 @@ func IsNotEndsWithAny(suffixes ...string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		for _, suffix := range suffixes {
 			if strings.HasSuffix(value, suffix) {
-				var ss []string
-				for _, suffix := range suffixes {
-					ss = append(ss, strconv.Quote(suffix))
-				}
-				return errorBuilder.BuildError(code.IsNotEndsWithAny, message.IsNotEndsWithAny, error2.NewParam("suffixes", strings.Join(ss, ", ")))
+				return errorBuilder.BuildError(code.IsNotEndsWithAny, message.IsNotEndsWithAny, error2.NewParam("suffixes", suffixes))
 			}
 		}
 		return nil
@@ -139,7 +122,7 @@ func IsNotEndsWithAny(suffixes ...string) StringRuleFunc {
 func IsMatch(pattern string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if !regexp.MustCompile(pattern).MatchString(value) {
-			return errorBuilder.BuildError(code.IsMatch, message.IsMatch, error2.NewParam("pattern", strconv.Quote(pattern)))
+			return errorBuilder.BuildError(code.IsMatch, message.IsMatch, error2.NewParam("pattern", pattern))
 		}
 		return nil
 	}
@@ -148,7 +131,7 @@ func IsMatch(pattern string) StringRuleFunc {
 func IsNotMatch(pattern string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if regexp.MustCompile(pattern).MatchString(value) {
-			return errorBuilder.BuildError(code.IsNotMatch, message.IsNotMatch, error2.NewParam("pattern", strconv.Quote(pattern)))
+			return errorBuilder.BuildError(code.IsNotMatch, message.IsNotMatch, error2.NewParam("pattern", pattern))
 		}
 		return nil
 	}
@@ -157,7 +140,7 @@ func IsNotMatch(pattern string) StringRuleFunc {
 func IsContains(substring string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if !strings.Contains(value, substring) {
-			return errorBuilder.BuildError(code.IsContains, message.IsContains, error2.NewParam("substring", strconv.Quote(substring)))
+			return errorBuilder.BuildError(code.IsContains, message.IsContains, error2.NewParam("substring", substring))
 		}
 		return nil
 	}
@@ -166,7 +149,7 @@ func IsContains(substring string) StringRuleFunc {
 func IsNotContains(substring string) StringRuleFunc {
 	return func(ctx context.Context, errorBuilder validation.ErrorBuilder, value string) validation.Error {
 		if strings.Contains(value, substring) {
-			return errorBuilder.BuildError(code.IsNotContains, message.IsNotContains, error2.NewParam("substring", strconv.Quote(substring)))
+			return errorBuilder.BuildError(code.IsNotContains, message.IsNotContains, error2.NewParam("substring", substring))
 		}
 		return nil
 	}
@@ -2,20 +2,18 @@ package validator
 
 import (
 	"context"
-	"strconv"
 	"time"
 
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/code"
 	error2 "github.com/gopi-frame/validation/errpack"
-	"github.com/gopi-frame/validation/is"
 	"github.com/gopi-frame/validation/message"
 )
 
 func IsTime(layout string) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.Time(s, layout) {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", strconv.Quote(layout)))
+		if _, err := time.Parse(layout, s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		}
 		return nil
 	}
@@ -95,8 +93,8 @@ func IsTimeOnly() StringRuleFunc {
 
 func IsDuration() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.Duration(s) {
-			return builder.BuildError(code.IsDuration, message.IsDuration)
+		if _, err := time.ParseDuration(s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsDuration, message.IsDuration), err)
 		}
 		return nil
 	}
@@ -104,8 +102,8 @@ func IsDuration() StringRuleFunc {
 
 func IsTimezone() StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
-		if !is.Timezone(s) {
-			return builder.BuildError(code.IsTimezone, message.IsTimezone)
+		if _, err := time.LoadLocation(s); err != nil {
+			return error2.WithCause(builder.BuildError(code.IsTimezone, message.IsTimezone), err)
 		}
 		return nil
 	}
@@ -114,12 +112,13 @@ func IsTimezone() StringRuleFunc {
 func IsBefore(layout string, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, time.Local); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if !t.Before(other) {
 			return builder.BuildError(
 				code.IsBefore,
 				message.IsBefore,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
 			)
 		}
 		return nil
@@ -129,13 +128,14 @@ func IsBefore(layout string, other time.Time) StringRuleFunc {
 func IsBeforeTZ(layout string, tz *time.Location, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, tz); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if !t.Before(other) {
 			return builder.BuildError(
 				code.IsBeforeTZ,
 				message.IsBeforeTZ,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
-				error2.NewParam("timezone", strconv.Quote(tz.String())),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
+				error2.NewParam("timezone", tz.String()),
 			)
 		}
 		return nil
@@ -145,12 +145,13 @@ func IsBeforeTZ(layout string, tz *time.Location, other time.Time) StringRuleFun
 func IsBeforeOrEqualTo(layout string, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, time.Local); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if t.After(other) {
 			return builder.BuildError(
 				code.IsBeforeOrEqualTo,
 				message.IsBeforeOrEqualTo,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
 			)
 		}
 		return nil
@@ -160,13 +161,14 @@ func IsBeforeOrEqualTo(layout string, other time.Time) StringRuleFunc {
 func IsBeforeOrEqualToTZ(layout string, tz *time.Location, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, tz); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if t.After(other) {
 			return builder.BuildError(
 				code.IsBeforeOrEqualToTZ,
 				message.IsBeforeOrEqualToTZ,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
-				error2.NewParam("timezone", strconv.Quote(tz.String())),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
+				error2.NewParam("timezone", tz.String()),
 			)
 		}
 		return nil
@@ -176,12 +178,13 @@ func IsBeforeOrEqualToTZ(layout string, tz *time.Location, other time.Time) Stri
 func IsAfter(layout string, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, time.Local); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if !t.After(other) {
 			return builder.BuildError(
 				code.IsAfter,
 				message.IsAfter,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
 			)
 		}
 		return nil
@@ -191,13 +194,14 @@ func IsAfter(layout string, other time.Time) StringRuleFunc {
 func IsAfterTZ(layout string, tz *time.Location, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, tz); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if !t.After(other) {
 			return builder.BuildError(
 				code.IsAfterTZ,
 				message.IsAfterTZ,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
-				error2.NewParam("timezone", strconv.Quote(tz.String())),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
+				error2.NewParam("timezone", tz.String()),
 			)
 		}
 		return nil
@@ -207,12 +211,13 @@ func IsAfterTZ(layout string, tz *time.Location, other time.Time) StringRuleFunc
 func IsAfterOrEqualTo(layout string, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, time.Local); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if t.Before(other) {
 			return builder.BuildError(
 				code.IsAfterOrEqualTo,
 				message.IsAfterOrEqualTo,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
 			)
 		}
 		return nil
@@ -222,13 +227,14 @@ func IsAfterOrEqualTo(layout string, other time.Time) StringRuleFunc {
 func IsAfterOrEqualToTZ(layout string, tz *time.Location, other time.Time) StringRuleFunc {
 	return func(ctx context.Context, builder validation.ErrorBuilder, s string) validation.Error {
 		if t, err := time.ParseInLocation(layout, s, tz); err != nil {
-			return builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("format", layout))
+			return error2.WithCause(builder.BuildError(code.IsTime, message.IsTime, error2.NewParam("layout", layout)), err)
 		} else if t.Before(other) {
 			return builder.BuildError(
 				code.IsAfterOrEqualToTZ,
 				message.IsAfterOrEqualToTZ,
-				error2.NewParam("time", strconv.Quote(other.Format(layout))),
-				error2.NewParam("timezone", strconv.Quote(tz.String())),
+				error2.NewParam("time", other),
+				error2.NewParam("layout", layout),
+				error2.NewParam("timezone", tz.String()),
 			)
 		}
 		return nil
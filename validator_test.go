@@ -2,12 +2,21 @@ package validation
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"html/template"
+	"io/fs"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gopi-frame/contract/validation"
 	"github.com/gopi-frame/validation/code"
+	"github.com/gopi-frame/validation/errpack"
 	"github.com/gopi-frame/validation/translator"
 	"github.com/gopi-frame/validation/validator"
 	"github.com/stretchr/testify/assert"
@@ -188,3 +197,980 @@ func TestValidator_GlobalCustomErrorMessage(t *testing.T) {
 		assert.Equal(t, "密码长度不能少于6", validated.GetError("password", code.IsMinLength).Error())
 	}
 }
+
+func TestValidate(t *testing.T) {
+	validated := Validate(context.Background(), NotBlank("name", ""), GreaterThan("age", 20, 18))
+	if assert.True(t, validated.Fails()) {
+		assert.True(t, validated.FailedAt("name", code.IsNotBlank))
+		assert.False(t, validated.HasError("age"))
+	}
+}
+
+func TestSetDefaultValidator(t *testing.T) {
+	original := defaultValidator.Load()
+	defer SetDefaultValidator(original)
+	v, err := NewValidator(WithMessages(map[string]string{
+		code.IsNotBlank: "{{.attribute}} is required",
+	}))
+	if !assert.NoError(t, err) {
+		assert.FailNow(t, err.Error())
+	}
+	SetDefaultValidator(v)
+	validated := Validate(context.Background(), NotBlank("name", ""))
+	if assert.True(t, validated.Fails()) {
+		assert.Equal(t, "name is required", validated.GetError("name", code.IsNotBlank).Error())
+	}
+}
+
+func TestValue(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		validated := Value(context.Background(), "gopi", validator.IsNotBlank[string](), validator.IsMinLength(3))
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		validated := Value(context.Background(), 16, validator.IsNotBlank[int](), validator.IsGreaterThanOrEqualTo(18))
+		if assert.True(t, validated.Fails()) {
+			assert.True(t, validated.FailedAt("", code.IsGreaterThanOrEqualTo))
+			assert.False(t, validated.GetError("", code.IsGreaterThanOrEqualTo).HasParam("attribute"))
+			assert.Equal(t, "value should be greater than or equal to 18.", validated.GetError("", code.IsGreaterThanOrEqualTo).Error())
+		}
+	})
+
+	t.Run("attached to an attribute", func(t *testing.T) {
+		validated := Value(context.Background(), "", validator.IsNotBlank[string]())
+		assert.Equal(t, "value should not be blank.", validated.GetError("", code.IsNotBlank).Error())
+		bag := errpack.NewBag()
+		bag.AddError("name", validated.AddParam(errpack.NewParam("attribute", "name")))
+		assert.Equal(t, "name should not be blank.", bag.GetError("name", code.IsNotBlank).Error())
+	})
+
+	t.Run("translated", func(t *testing.T) {
+		translator.RegisterTranslation("test-value", map[string]string{
+			code.IsNotBlank:   "{{.attribute}}不能为空。",
+			"attribute.value": "值",
+		})
+		validated := Value(BindLanguage(context.Background(), "test-value"), "", validator.IsNotBlank[string]())
+		assert.Equal(t, "值不能为空。", validated.GetError("", code.IsNotBlank).Error())
+	})
+}
+
+func TestAttribute(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		validated := Attribute(context.Background(), "User", &mockUser{Username: "gopi", Password: "123456", Age: 25})
+		assert.False(t, validated.Fails())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		validated := Attribute(context.Background(), "User", &mockUser{Username: "gopi", Password: "123", Age: 16})
+		if assert.True(t, validated.Fails()) {
+			assert.True(t, validated.FailedAt("User.password", code.IsMinLength))
+			assert.True(t, validated.FailedAt("User.age", code.IsGreaterThanOrEqualTo))
+		}
+	})
+}
+
+func TestValidator_Order(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	for i := 0; i < 10; i++ {
+		validated := v.Validate(
+			context.Background(),
+			EqualTo("name", "go", "gopi"),
+			Group("password", "aB3", validator.IsMinLength(6), validator.IsMatch("^[a-z]+$"), validator.IsUpper()),
+			GreaterThan("age", 16, 18),
+			StartsWith("email", "gopi", "@"),
+		)
+		assert.Equal(t, []string{"name", "password", "age", "email"}, validated.Failed())
+		assert.Equal(t, "name: name should be equal to gopi.\n"+
+			"password: password should have length greater than or equal to 6.\n"+
+			"password: password should match \"^[a-z]+$\".\n"+
+			"password: password should be uppercase.\n"+
+			"age: age should be greater than 18.\n"+
+			"email: email should start with \"@\".\n", validated.Error())
+		var keys []string
+		validated.Each(func(key string, errs validation.Errors) bool {
+			keys = append(keys, key)
+			return true
+		})
+		assert.Equal(t, []string{"name", "password", "age", "email"}, keys)
+		var codes []string
+		validated.GetErrors("password").Each(func(code string, err validation.Error) bool {
+			codes = append(codes, code)
+			return true
+		})
+		assert.Equal(t, []string{code.IsMinLength, code.IsMatch, code.IsUpper}, codes)
+		data, err := json.Marshal(validated)
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"name":[{"code":"is_equal","message":"name should be equal to gopi.","severity":"error"}],`+
+				`"password":[{"code":"is_min_length","message":"password should have length greater than or equal to 6.","severity":"error"},`+
+				`{"code":"is_match","message":"password should match \"^[a-z]+$\".","severity":"error"},`+
+				`{"code":"is_upper","message":"password should be uppercase.","severity":"error"}],`+
+				`"age":[{"code":"is_greater_than","message":"age should be greater than 18.","severity":"error"}],`+
+				`"email":[{"code":"is_starts_with","message":"email should start with \"@\".","severity":"error"}]}`, string(data))
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	validated := Validate(context.Background(),
+		Group("password", "abc", validator.IsMatch("[0-9]"), validator.IsMinLength(6), validator.IsMatch("[A-Z]")),
+	)
+	errs, ok := validated.GetErrors("password").(errpack.Errors)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Len(t, errs, 3)
+	assert.Equal(t, code.IsMatch, errs[0].Code())
+	assert.Equal(t, code.IsMinLength, errs[1].Code())
+	if all := errs.GetAll(code.IsMatch); assert.Len(t, all, 2) {
+		assert.Equal(t, "password should match \"[0-9]\".", all[0].Error())
+		assert.Equal(t, "password should match \"[A-Z]\".", all[1].Error())
+	}
+
+	delete(errs, 1)
+	errs.Add(errpack.NewError(code.IsNotBlank, "{{.attribute}} should not be blank.", errpack.NewParam("attribute", "password")))
+	assert.Len(t, errs, 3)
+	assert.Equal(t, code.IsNotBlank, errs[3].Code())
+	assert.Equal(t, "password should match \"[0-9]\"., password should match \"[A-Z]\"., password should not be blank.", errs.Error())
+	assert.Len(t, validated.GetErrors("password"), 3)
+
+	missing := validated.GetErrors("missing")
+	assert.False(t, missing.Has(code.IsMatch))
+	data, err := json.Marshal(missing)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "[]", string(data))
+	}
+}
+
+func TestValidator_Concurrency(t *testing.T) {
+	t.Run("invalid option", func(t *testing.T) {
+		_, err := NewValidator(WithConcurrency(0))
+		assert.Error(t, err)
+	})
+
+	t.Run("same result as serial", func(t *testing.T) {
+		v, err := NewValidator(WithConcurrency(4))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		var builders []validation.ValidatorBuilder
+		var keys []string
+		for i := 0; i < 50; i++ {
+			key := "field" + strconv.Itoa(i)
+			keys = append(keys, key)
+			builders = append(builders, NotBlank(key, ""), MinLength(key, "", 3))
+		}
+		validated := v.Validate(context.Background(), builders...)
+		assert.Nil(t, validated.(*errpack.Bag).Err())
+		assert.Equal(t, keys, validated.Failed())
+		for _, key := range keys {
+			assert.Len(t, validated.GetErrors(key), 2)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		for _, options := range [][]Option{nil, {WithConcurrency(2)}} {
+			v, err := NewValidator(options...)
+			if err != nil {
+				assert.FailNow(t, err.Error())
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			var count atomic.Int32
+			rule := validator.RuleFunc[string](func(ctx context.Context, builder validation.ErrorBuilder, value string) validation.Error {
+				if count.Add(1) == 2 {
+					cancel()
+				}
+				return nil
+			})
+			var builders []validation.ValidatorBuilder
+			for i := 0; i < 10; i++ {
+				builders = append(builders, Group("field"+strconv.Itoa(i), "", rule))
+			}
+			validated := v.Validate(ctx, builders...)
+			assert.True(t, validated.Fails(), "an interrupted validation does not pass")
+			assert.Empty(t, validated.Failed())
+			assert.ErrorIs(t, validated, context.Canceled)
+			assert.Contains(t, validated.Error(), "validation interrupted: context canceled")
+			assert.ErrorIs(t, validated.(*errpack.Bag).Err(), context.Canceled)
+			assert.Less(t, count.Load(), int32(10))
+		}
+	})
+}
+
+func TestValidator_AttributeNames(t *testing.T) {
+	translator.RegisterTranslation("test-attribute-zh", map[string]string{
+		code.IsNotBlank:   "{{.attribute}}不能为空。",
+		code.IsSameAs:     "{{.attribute}}必须与{{.other}}相同。",
+		"attribute.email": "邮箱",
+	})
+	v, err := NewValidator(WithAttributeNames(map[string]string{
+		"email":              "E-mail address",
+		"email_confirmation": "E-mail confirmation",
+	}))
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+
+	t.Run("validator names", func(t *testing.T) {
+		validated := v.Validate(context.Background(), NotBlank("email", ""), NotBlank("phone", ""))
+		assert.Equal(t, "E-mail address should not be blank.", validated.GetError("email", code.IsNotBlank).Error())
+		assert.Equal(t, "phone should not be blank.", validated.GetError("phone", code.IsNotBlank).Error())
+	})
+
+	t.Run("other", func(t *testing.T) {
+		validated := v.Validate(context.Background(), SameAs("email", "a@example.com", "email_confirmation", "b@example.com"))
+		assert.Equal(t, "E-mail address should be the same as E-mail confirmation.", validated.GetError("email", code.IsSameAs).Error())
+	})
+
+	t.Run("translated names", func(t *testing.T) {
+		ctx := BindLanguage(context.Background(), "test-attribute-zh")
+		validated := v.Validate(ctx, NotBlank("email", ""), SameAs("email", "a@example.com", "email_confirmation", "b@example.com"))
+		assert.Equal(t, "邮箱不能为空。", validated.GetError("email", code.IsNotBlank).Error())
+		// the names of the validator are for the default language only.
+		assert.Equal(t, "邮箱必须与email_confirmation相同。", validated.GetError("email", code.IsSameAs).Error())
+	})
+
+	t.Run("default language", func(t *testing.T) {
+		v, err := NewValidator(WithDefaultLanguage("test-attribute-zh"), WithAttributeNames(map[string]string{
+			"email_confirmation": "确认邮箱",
+		}))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(context.Background(), SameAs("email", "a@example.com", "email_confirmation", "b@example.com"))
+		assert.Equal(t, "邮箱必须与确认邮箱相同。", validated.GetError("email", code.IsSameAs).Error())
+		validated = v.Validate(BindLanguage(context.Background(), "Test-Attribute-ZH"), SameAs("email", "a@example.com", "email_confirmation", "b@example.com"))
+		assert.Equal(t, "邮箱必须与确认邮箱相同。", validated.GetError("email", code.IsSameAs).Error())
+		validated = v.Validate(BindLanguage(context.Background(), "en"), NotBlank("email_confirmation", ""))
+		assert.Equal(t, "email_confirmation should not be blank.", validated.GetError("email_confirmation", code.IsNotBlank).Error())
+	})
+
+	t.Run("per-call overrides", func(t *testing.T) {
+		ctx := BindAttributeNames(context.Background(), map[string]string{"email": "Email"})
+		validated := v.Validate(ctx, NotBlank("email", ""))
+		assert.Equal(t, "Email should not be blank.", validated.GetError("email", code.IsNotBlank).Error())
+
+		ctx = BindLanguage(ctx, "test-attribute-zh")
+		validated = v.Validate(ctx, NotBlank("email", ""))
+		assert.Equal(t, "Email不能为空。", validated.GetError("email", code.IsNotBlank).Error())
+	})
+
+	t.Run("without names", func(t *testing.T) {
+		v, _ := NewValidator()
+		validated := v.Validate(context.Background(), NotBlank("email_address", ""))
+		assert.Equal(t, "email_address should not be blank.", validated.GetError("email_address", code.IsNotBlank).Error())
+	})
+}
+
+func TestBindAcceptLanguage(t *testing.T) {
+	translator.RegisterTranslation("test-accept-zh-hans", map[string]string{
+		code.IsNotBlank: "{{.attribute}}不能为空。",
+	})
+	translator.RegisterTranslation("test-accept-de", map[string]string{
+		code.IsNotBlank: "{{.attribute}} darf nicht leer sein.",
+	})
+	v, err := NewValidator()
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"test-accept-zh-Hans-CN, test-accept-de;q=0.9", "name不能为空。"},
+		{"test-accept-zh-Hans;q=0.5, test-accept-de;q=0.9", "name darf nicht leer sein."},
+		{"fr-CH, test-accept-de-AT;q=0.8", "name darf nicht leer sein."},
+		{"fr-CH, fr;q=0.9", "name should not be blank."},
+		{"", "name should not be blank."},
+	}
+	for _, test := range tests {
+		ctx := BindAcceptLanguage(context.Background(), test.header)
+		validated := v.Validate(ctx, NotBlank("name", ""))
+		assert.Equal(t, test.want, validated.GetError("name", code.IsNotBlank).Error(), test.header)
+	}
+
+	ctx := BindPreferredLanguage(context.Background(), "unknown", "TEST_ACCEPT_DE")
+	assert.Equal(t, "test-accept-de", LanguageFromContext(ctx))
+	ctx = BindPreferredLanguage(context.Background(), "unknown")
+	assert.Equal(t, "", LanguageFromContext(ctx))
+}
+
+func TestValidator_SafeRendering(t *testing.T) {
+	t.Run("malformed messages option", func(t *testing.T) {
+		_, err := NewValidator(WithMessages(map[string]string{
+			code.IsNotBlank: "{{.attribute should not be blank.",
+		}))
+		assert.ErrorContains(t, err, code.IsNotBlank)
+	})
+
+	var reported []error
+	v, err := NewValidator(WithErrorHandler(func(err error) {
+		reported = append(reported, err)
+	}))
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+
+	t.Run("malformed custom message", func(t *testing.T) {
+		reported = nil
+		validated := v.Validate(context.Background(), NotBlank("name", "")).SetMessages(map[string]map[string]string{
+			"name": {code.IsNotBlank: "{{.attribute is required"},
+		})
+		assert.NotPanics(t, func() {
+			assert.Equal(t, "name should not be blank.", validated.GetError("name", code.IsNotBlank).Error())
+		})
+		if assert.Len(t, reported, 1) {
+			assert.ErrorContains(t, reported[0], code.IsNotBlank)
+		}
+	})
+
+	t.Run("failed custom message", func(t *testing.T) {
+		reported = nil
+		validated := v.Validate(context.Background(), NotBlank("name", "")).SetMessages(map[string]map[string]string{
+			"name": {code.IsNotBlank: "{{.attribute.Name}} is required"},
+		})
+		assert.NotPanics(t, func() {
+			assert.Equal(t, "name should not be blank.", validated.GetError("name", code.IsNotBlank).Error())
+		})
+		assert.Len(t, reported, 1)
+	})
+
+	t.Run("malformed default message", func(t *testing.T) {
+		reported = nil
+		err := v.BuildError("custom", "{{.attribute is broken").(*errpack.Error).SetTranslator(nil)
+		assert.NotPanics(t, func() {
+			assert.Equal(t, "{{.attribute is broken", err.Error())
+		})
+		assert.Len(t, reported, 1)
+	})
+}
+
+func TestValidator_Funcs(t *testing.T) {
+	t.Run("typed params", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(context.Background(), In("role", "guest", "admin", "editor"), NotIn("level", 3, 1, 2, 3))
+		assert.Equal(t, `role should be one of "admin", "editor".`, validated.GetError("role", code.IsIn).Error())
+		assert.Equal(t, `level should not be one of "1", "2", "3".`, validated.GetError("level", code.IsNotIn).Error())
+
+		param := errpack.NewParam("values", []int{1, 2})
+		assert.Equal(t, []int{1, 2}, param.Raw())
+		assert.Equal(t, "[1 2]", param.Value())
+	})
+
+	t.Run("default funcs", func(t *testing.T) {
+		v, err := NewValidator(WithMessages(map[string]string{
+			code.IsGreaterThan: "{{upper .attribute}} should be greater than {{number .value}}.",
+			code.IsMaxCount:    "{{.attribute}} should have at most {{.count}} {{plural .count \"item\" \"items\"}}.",
+		}))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(context.Background(),
+			GreaterThan("age", 14, 1234567),
+			MaxCount("tags", []string{"a", "b"}, 1),
+		)
+		assert.Equal(t, "AGE should be greater than 1,234,567.", validated.GetError("age", code.IsGreaterThan).Error())
+		assert.Equal(t, "tags should have at most 1 item.", validated.GetError("tags", code.IsMaxCount).Error())
+
+		deadline := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		e := v.BuildError("deadline", `{{.attribute}} should be before {{date "2006-01-02" .time}}.`,
+			errpack.NewParam("attribute", "due"), errpack.NewParam("time", deadline))
+		assert.Equal(t, "due should be before 2024-05-01.", e.Error())
+	})
+
+	t.Run("validator funcs", func(t *testing.T) {
+		v, err := NewValidator(
+			WithMessages(map[string]string{
+				code.IsNotBlank: "{{shout .attribute}} is required",
+			}),
+			WithFuncs(template.FuncMap{
+				"shout": func(s string) string { return strings.ToUpper(s) + "!" },
+			}),
+		)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(context.Background(), NotBlank("name", ""))
+		assert.Equal(t, "NAME! is required", validated.GetError("name", code.IsNotBlank).Error())
+
+		_, err = NewValidator(WithMessages(map[string]string{
+			code.IsNotBlank: "{{shout .attribute}} is required",
+		}))
+		assert.Error(t, err)
+	})
+	t.Run("templates per validator", func(t *testing.T) {
+		messages := WithMessages(map[string]string{code.IsNotBlank: "{{mark .attribute}} is required"})
+		v1, err := NewValidator(messages, WithFuncs(template.FuncMap{"mark": func(s string) string { return "*" + s }}))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		v2, err := NewValidator(messages, WithFuncs(template.FuncMap{"mark": func(s string) string { return s + "?" }}))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.Equal(t, "*name is required", v1.Validate(context.Background(), NotBlank("name", "")).GetError("name", code.IsNotBlank).Error())
+		assert.Equal(t, "name? is required", v2.Validate(context.Background(), NotBlank("name", "")).GetError("name", code.IsNotBlank).Error())
+	})
+
+	t.Run("many messages", func(t *testing.T) {
+		templates := errpack.NewTemplates(nil)
+		for i := 0; i < 2000; i++ {
+			message := "{{.attribute}} " + strconv.Itoa(i)
+			e := errpack.NewError(code.IsNotBlank, message, errpack.NewParam("attribute", "name")).SetTemplates(templates)
+			assert.Equal(t, "name "+strconv.Itoa(i), e.Error())
+		}
+	})
+}
+
+func TestValidator_Export(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	validated := v.Validate(context.Background(),
+		NotBlank("name", ""),
+		In("items.0/role", "guest", "admin", "editor"),
+		MaxCount("tags", []string{"a", "b"}, 1),
+	)
+
+	t.Run("list", func(t *testing.T) {
+		list := errpack.ToList(validated)
+		assert.Len(t, list, 3)
+		assert.Equal(t, "name", list[0].Field)
+		assert.Equal(t, code.IsNotBlank, list[0].Code)
+		assert.Equal(t, "name should not be blank.", list[0].Message)
+		assert.Equal(t, []string{"admin", "editor"}, list[1].Params["values"])
+		assert.Equal(t, 1, list[2].Params["count"])
+		content, err := json.Marshal(list[2])
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.JSONEq(t, `{"field":"tags","code":"`+code.IsMaxCount+`","message":"tags should contain at most 1 element.","severity":"error","params":{"attribute":"tags","count":1}}`, string(content))
+	})
+
+	t.Run("problem", func(t *testing.T) {
+		problem := errpack.ToProblem(validated, 422, "Your request parameters didn't validate.")
+		content, err := json.Marshal(problem)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		var document map[string]any
+		assert.Nil(t, json.Unmarshal(content, &document))
+		assert.Equal(t, "Your request parameters didn't validate.", document["title"])
+		assert.Equal(t, float64(422), document["status"])
+		params := document["invalid-params"].([]any)
+		assert.Len(t, params, 3)
+		assert.Equal(t, "name", params[0].(map[string]any)["name"])
+		assert.Equal(t, "name should not be blank.", params[0].(map[string]any)["reason"])
+	})
+
+	t.Run("json api", func(t *testing.T) {
+		document := errpack.ToJSONAPI(validated, "422")
+		assert.Len(t, document.Errors, 3)
+		assert.Equal(t, "/data/attributes/name", document.Errors[0].Source.Pointer)
+		assert.Equal(t, "/data/attributes/items/0~1role", document.Errors[1].Source.Pointer)
+		assert.Equal(t, "422", document.Errors[1].Status)
+		assert.Equal(t, []string{"admin", "editor"}, document.Errors[1].Meta["values"])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		validated := v.Validate(context.Background(), NotBlank("name", "gopher"))
+		content, err := json.Marshal(errpack.ToJSONAPI(validated, ""))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.JSONEq(t, `{"errors":[]}`, string(content))
+		assert.Empty(t, errpack.ToList(validated))
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		other := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		validated := v.Validate(context.Background(), BeforeTZ("date", "2024-09-02 00:00:00", time.DateTime, time.UTC, other))
+		list := errpack.ToList(validated)
+		if assert.Len(t, list, 1) {
+			content, err := json.Marshal(list[0].Params)
+			if err != nil {
+				assert.FailNow(t, err.Error())
+			}
+			assert.JSONEq(t, `{"attribute":"date","layout":"2006-01-02 15:04:05","time":"2024-09-01T00:00:00Z","timezone":"UTC"}`, string(content))
+		}
+	})
+}
+
+func TestValidator_Path(t *testing.T) {
+	t.Run("formats", func(t *testing.T) {
+		path := errpack.Path{"hosts", "example.com", "a/b~c", "0"}
+		assert.Equal(t, `hosts.example\.com.a/b~c.0`, path.String())
+		assert.Equal(t, "/hosts/example.com/a~1b~0c/0", path.Pointer())
+		assert.Equal(t, "hosts[example.com][a/b~c][0]", path.Brackets())
+		assert.Equal(t, path, errpack.ParsePath(path.String()))
+		assert.Equal(t, errpack.Path{"items", "0", "name"}, errpack.ParsePath("items.0.name"))
+		assert.Equal(t, errpack.Path{`a\b`, "c"}, errpack.ParsePath(`a\\b.c`))
+		assert.Nil(t, errpack.ParsePath(""))
+		assert.Equal(t, "", errpack.Path(nil).Pointer())
+	})
+
+	t.Run("backslashes", func(t *testing.T) {
+		for _, path := range []errpack.Path{
+			{`C:\tmp`},
+			{`a\b`},
+			{`a\\b`},
+			{`a\`, "b"},
+			{"a", `b\`},
+			{`a\.b`, `\\`},
+			{`\`, `\`},
+		} {
+			assert.Equal(t, path, errpack.ParsePath(path.String()), path.String())
+		}
+		assert.Equal(t, `C:\tmp`, errpack.Path{`C:\tmp`}.String())
+		assert.Equal(t, `a\b`, errpack.Path{`a\b`}.String())
+
+		bag := errpack.NewBag()
+		bag.AddError(`C:\tmp`, errpack.NewError(code.IsPathExists, "missing"))
+		assert.True(t, bag.HasError(`C:\tmp`))
+		assert.Equal(t, []string{`C:\tmp`}, bag.Failed())
+		bag.AddError(`a\\b`, errpack.NewError(code.IsNotBlank, "blank"))
+		assert.True(t, bag.HasError(`a\\b`))
+		assert.True(t, bag.HasError(`a\b`))
+		assert.Equal(t, errpack.Path{`a\b`}, bag.Path(`a\\b`))
+		customized := bag.SetMessages(map[string]map[string]string{`a\\b`: {code.IsNotBlank: "required"}})
+		assert.Equal(t, []string{"required"}, customized.GetMessage(`a\b`))
+
+		v, err := NewValidator()
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(context.Background(), NotBlank(`a\b`, ""))
+		assert.Equal(t, []string{`a\b`}, validated.Failed())
+		assert.Equal(t, `a\b should not be blank.`, validated.GetError(`a\b`, code.IsNotBlank).Error())
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		v, err := NewValidator()
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(context.Background(),
+			NotBlank("email", "").SetKey("user", "email"),
+			NewBuilder(validator.IsNotBlank[string]().SetValue("")).SetPath(errpack.Path{"hosts", "example.com"}).SetAttribute("host"),
+			Each("tags", []string{"a", ""}, validator.IsNotBlank[string]()),
+		).(*errpack.Bag)
+		assert.Equal(t, []string{"user.email", `hosts.example\.com`, "tags.1"}, validated.Failed())
+		assert.Equal(t, errpack.Path{"hosts", "example.com"}, validated.Path(`hosts.example\.com`))
+		assert.Equal(t, "/hosts/example.com", validated.Path(`hosts.example\.com`).Pointer())
+		assert.Equal(t, "tags[1]", validated.Path("tags.1").Brackets())
+		assert.Equal(t, []errpack.Path{{"user", "email"}, {"hosts", "example.com"}, {"tags", "1"}}, validated.Paths())
+		assert.Equal(t, "/data/attributes/hosts/example.com", errpack.ToJSONAPI(validated, "").Errors[1].Source.Pointer)
+	})
+
+	t.Run("nested bag", func(t *testing.T) {
+		inner := errpack.NewBag()
+		inner.AddErrorAt(errpack.Path{"example.com"}, errpack.NewError(code.IsNotBlank, "blank"))
+		bag := errpack.NewBag()
+		bag.AddErrorAt(errpack.Path{"hosts"}, inner)
+		assert.Equal(t, errpack.Path{"hosts", "example.com"}, bag.Paths()[0])
+		assert.True(t, bag.HasError(`hosts.example\.com`))
+	})
+}
+
+func TestValidator_CustomMessages(t *testing.T) {
+	type item struct {
+		Name string `json:"name" validate:"required"`
+	}
+	type order struct {
+		Name  string `json:"name" validate:"required"`
+		Items []item `json:"items"`
+	}
+	translator.RegisterTranslation("test-custom-messages", map[string]string{
+		code.IsNotBlank: "{{.attribute}} est requis.",
+	})
+	v, err := NewValidator(
+		WithMessages(map[string]string{
+			code.IsNotBlank: "{{.attribute}} is required.",
+		}),
+		WithCustomMessages(map[string]map[string]string{
+			"name":         {code.IsNotBlank: "Give the order a name."},
+			"items.*.name": {code.IsNotBlank: "Every item needs a name."},
+			"items.1.name": {code.IsNotBlank: "The second item needs a name."},
+		}),
+		WithCustomMessages(map[string]map[string]string{
+			"items.*.name": {code.IsNotBlank: "Chaque article doit avoir un nom."},
+		}, "test-custom-messages"),
+	)
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	value := order{Items: []item{{}, {}, {Name: "pen"}}}
+
+	t.Run("precedence", func(t *testing.T) {
+		validated, err := v.ValidateStruct(context.Background(), value)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.Equal(t, "Give the order a name.", validated.GetError("name", code.IsNotBlank).Error())
+		assert.Equal(t, "Every item needs a name.", validated.GetError("items.0.name", code.IsNotBlank).Error())
+		assert.Equal(t, "The second item needs a name.", validated.GetError("items.1.name", code.IsNotBlank).Error())
+
+		validated = v.Validate(context.Background(), NotBlank("title", ""))
+		assert.Equal(t, "title is required.", validated.GetError("title", code.IsNotBlank).Error())
+	})
+
+	t.Run("locale", func(t *testing.T) {
+		ctx := BindLanguage(context.Background(), "test-custom-messages-x")
+		validated, err := v.ValidateStruct(ctx, value)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.Equal(t, "Chaque article doit avoir un nom.", validated.GetError("items.0.name", code.IsNotBlank).Error())
+		assert.Equal(t, "Chaque article doit avoir un nom.", validated.GetError("items.1.name", code.IsNotBlank).Error())
+		assert.Equal(t, "Give the order a name.", validated.GetError("name", code.IsNotBlank).Error())
+	})
+
+	t.Run("translator", func(t *testing.T) {
+		v, err := NewValidator(WithCustomMessages(map[string]map[string]string{
+			"name": {code.IsNotBlank: "Give the order a name."},
+		}, "en"))
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		validated := v.Validate(BindLanguage(context.Background(), "test-custom-messages"), NotBlank("name", ""), NotBlank("title", ""))
+		assert.Equal(t, "name est requis.", validated.GetError("name", code.IsNotBlank).Error())
+		assert.Equal(t, "title est requis.", validated.GetError("title", code.IsNotBlank).Error())
+	})
+
+	t.Run("catalog", func(t *testing.T) {
+		translator.RegisterTranslation("test-custom-catalog", map[string]string{
+			"custom.name.is_not_blank":         "Donnez un nom à la commande.",
+			"custom.items.1.name.is_not_blank": "Le deuxième article doit avoir un nom.",
+		})
+		validated, err := v.ValidateStruct(BindLanguage(context.Background(), "test-custom-catalog"), value)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.Equal(t, "Donnez un nom à la commande.", validated.GetError("name", code.IsNotBlank).Error())
+		assert.Equal(t, "Le deuxième article doit avoir un nom.", validated.GetError("items.1.name", code.IsNotBlank).Error())
+		assert.Equal(t, "Every item needs a name.", validated.GetError("items.0.name", code.IsNotBlank).Error())
+
+		validated, err = v.ValidateStruct(context.Background(), value)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.Equal(t, "Give the order a name.", validated.GetError("name", code.IsNotBlank).Error())
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := NewValidator(WithCustomMessages(map[string]map[string]string{
+			"name": {code.IsNotBlank: "{{.attribute"},
+		}))
+		assert.Error(t, err)
+	})
+}
+
+func TestValidator_Severity(t *testing.T) {
+	v, err := NewValidator(WithBail())
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+
+	t.Run("warnings", func(t *testing.T) {
+		validated := v.Validate(context.Background(),
+			Warn(MinLength("password", "secret1", 10)),
+			Notice(StartsWith("url", "http://example.com", "https://")),
+			NotBlank("name", "gopher"),
+		).(*errpack.Bag)
+		assert.False(t, validated.Fails())
+		assert.Empty(t, validated.Failed())
+		assert.False(t, validated.HasError("password"))
+		assert.False(t, validated.FailedAt("password", code.IsMinLength))
+		assert.True(t, validated.HasWarnings())
+		warnings := validated.Warnings()
+		assert.Equal(t, []errpack.Path{{"password"}, {"url"}}, warnings.Paths())
+		assert.Equal(t, errpack.SeverityWarning, errpack.SeverityOf(warnings.GetError("password", code.IsMinLength)))
+		assert.Equal(t, errpack.SeverityNotice, errpack.SeverityOf(warnings.GetError("url", code.IsStartsWith)))
+
+		content, err := json.Marshal(validated)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		assert.JSONEq(t, `{"password":[{"code":"is_min_length","message":"password should have length greater than or equal to 10.","severity":"warning"}],`+
+			`"url":[{"code":"is_starts_with","message":"url should start with \"https://\".","severity":"notice"}]}`, string(content))
+		assert.Empty(t, errpack.ToJSONAPI(validated, "").Errors)
+		assert.Equal(t, errpack.SeverityWarning, errpack.ToList(validated)[0].Severity)
+	})
+
+	t.Run("mixed", func(t *testing.T) {
+		validated := v.Validate(context.Background(),
+			Warn(MinLength("password", "abc", 10)),
+			Match("password", "abc", `\d`),
+		).(*errpack.Bag)
+		assert.True(t, validated.Fails())
+		assert.Equal(t, []string{"password"}, validated.Failed())
+		assert.True(t, validated.FailedAt("password", code.IsMatch))
+		assert.False(t, validated.FailedAt("password", code.IsMinLength))
+		assert.Len(t, validated.GetMessage("password"), 2, "warnings don't bail")
+		assert.Equal(t, []string{"password should have length greater than or equal to 10."}, validated.Warnings().GetMessage("password"))
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		validated := v.Validate(context.Background(),
+			Group("password", "abc", validator.Warn(validator.IsMinLength(10)), validator.IsNotBlank[string]()),
+			Each("tags", []string{"", "b"}, validator.Notice(validator.IsNotBlank[string]())),
+		).(*errpack.Bag)
+		assert.False(t, validated.Fails())
+		assert.Equal(t, []errpack.Path{{"password"}, {"tags", "0"}}, validated.Warnings().Paths())
+	})
+}
+
+func TestValidator_Unwrap(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	validated := v.Validate(context.Background(),
+		NotBlank("name", ""),
+		PathFile("config", "/path/to/missing.yaml"),
+		Before("deadline", "tomorrow", time.DateOnly, time.Now()),
+		Each("tags", []string{"a", ""}, validator.IsNotBlank[string]()),
+	)
+
+	t.Run("code", func(t *testing.T) {
+		assert.ErrorIs(t, validated, errpack.Code(code.IsNotBlank))
+		assert.ErrorIs(t, validated, errpack.Code(code.IsPathFile))
+		assert.NotErrorIs(t, validated, errpack.Code(code.IsUUID))
+		assert.ErrorIs(t, validated.GetError("tags.1", code.IsNotBlank), errpack.Code(code.IsNotBlank))
+		assert.Len(t, validated.(*errpack.Bag).Unwrap(), 4)
+	})
+
+	t.Run("cause", func(t *testing.T) {
+		assert.ErrorIs(t, validated, fs.ErrNotExist)
+		var pathErr *fs.PathError
+		if assert.ErrorAs(t, validated, &pathErr) {
+			assert.Equal(t, "/path/to/missing.yaml", pathErr.Path)
+		}
+		var parseErr *time.ParseError
+		if assert.ErrorAs(t, validated, &parseErr) {
+			assert.Equal(t, "tomorrow", parseErr.Value)
+		}
+		e := validated.GetError("config", code.IsPathFile).(*errpack.Error)
+		assert.ErrorIs(t, e.Cause(), fs.ErrNotExist)
+		assert.Nil(t, validated.GetError("name", code.IsNotBlank).(*errpack.Error).Cause())
+	})
+
+	t.Run("parser causes", func(t *testing.T) {
+		validated := v.Validate(context.Background(),
+			URL("homepage", "http://[::1"),
+			RequestURI("path", "relative/path"),
+			Time("start", "9:30", time.Kitchen),
+			Duration("timeout", "10 minutes"),
+		)
+		var urlErr *url.Error
+		if assert.ErrorAs(t, validated.GetError("homepage", code.IsURL), &urlErr) {
+			assert.Equal(t, "parse", urlErr.Op)
+		}
+		assert.ErrorAs(t, validated.GetError("path", code.IsRequestURI), &urlErr)
+		var parseErr *time.ParseError
+		if assert.ErrorAs(t, validated.GetError("start", code.IsTime), &parseErr) {
+			assert.Equal(t, "9:30", parseErr.Value)
+		}
+		assert.ErrorContains(t, validated.GetError("timeout", code.IsDuration).(*errpack.Error).Cause(), "10 minutes")
+	})
+
+	t.Run("custom error", func(t *testing.T) {
+		cause := errors.New("parse failed")
+		e := errpack.WithCause(&mockError{code: code.IsURL, message: "not a url"}, cause)
+		assert.Equal(t, code.IsURL, e.Code())
+		assert.Equal(t, "not a url", e.Error())
+		assert.ErrorIs(t, e, cause)
+		var mock *mockError
+		assert.ErrorAs(t, e, &mock)
+		assert.ErrorIs(t, e.SetMessage("invalid url"), cause)
+		assert.Equal(t, "invalid url", e.SetMessage("invalid url").Error())
+		warning := errpack.WithCause(&mockError{code: code.IsURL, severity: errpack.SeverityWarning}, cause)
+		assert.Equal(t, errpack.SeverityWarning, errpack.SeverityOf(warning))
+		assert.ErrorIs(t, errpack.WithSeverity(e, errpack.SeverityWarning), cause)
+	})
+
+	t.Run("nested params", func(t *testing.T) {
+		e := errpack.NewError(code.IsAnyOf, "none matched", errpack.NewErrorsParam("errors",
+			errpack.NewError(code.IsUUID, "not a uuid"),
+			errpack.NewError(code.IsURL, "not a url").SetCause(errors.New("parse failed")),
+		))
+		assert.ErrorIs(t, e, errpack.Code(code.IsAnyOf))
+		assert.ErrorIs(t, e, errpack.Code(code.IsURL))
+		assert.NotErrorIs(t, e, errpack.Code(code.IsIP))
+	})
+}
+
+// mockError is an error of another type than errpack.Error, as built by a custom error builder.
+type mockError struct {
+	code     string
+	message  string
+	severity errpack.Severity
+}
+
+func (e *mockError) Error() string                              { return e.message }
+func (e *mockError) Code() string                               { return e.code }
+func (e *mockError) Message() string                            { return e.message }
+func (e *mockError) Params() []validation.Param                 { return nil }
+func (e *mockError) HasParam(string) bool                       { return false }
+func (e *mockError) AddParam(validation.Param) validation.Error { return e }
+func (e *mockError) Severity() errpack.Severity                 { return e.severity }
+func (e *mockError) SetMessage(message string) validation.Error {
+	return &mockError{code: e.code, message: message, severity: e.severity}
+}
+
+// sharedErrorBuilder returns the same error for each code, like a builder pooling its errors.
+type sharedErrorBuilder struct {
+	errors sync.Map
+}
+
+func (b *sharedErrorBuilder) BuildError(code string, message string, params ...validation.Param) validation.Error {
+	err, _ := b.errors.LoadOrStore(code, errpack.NewError(code, message, params...).SetTranslator(translator.New()))
+	return err.(validation.Error)
+}
+
+func TestValidator_ImmutableErrors(t *testing.T) {
+	t.Run("copy on write", func(t *testing.T) {
+		e := errpack.NewError(code.IsNotBlank, "{{.attribute}} should not be blank.", errpack.NewParam("attribute", "name"))
+		assert.Equal(t, "name should not be blank.", e.Error())
+		e2 := e.AddParam(errpack.NewParam("attribute", "email"))
+		e3 := e2.SetMessage("{{.attribute}} is required.")
+		assert.Equal(t, "name should not be blank.", e.Error())
+		assert.Equal(t, "email should not be blank.", e2.Error())
+		assert.Equal(t, "email is required.", e3.Error())
+		assert.Equal(t, "name", e.Params()[0].Value())
+
+		nested := errpack.NewError(code.IsAnyOf, "{{.errors}}", errpack.NewErrorsParam("errors",
+			errpack.NewError(code.IsUUID, "{{.attribute}} should be a uuid."),
+		))
+		withAttribute := nested.AddParam(errpack.NewParam("attribute", "id"))
+		assert.Equal(t, "id should be a uuid.", withAttribute.Error())
+		assert.Equal(t, "value should be a uuid.", nested.Error())
+	})
+
+	t.Run("bag", func(t *testing.T) {
+		bag := errpack.NewBag()
+		bag.AddError("name", errpack.NewError(code.IsNotBlank, "{{.attribute}} should not be blank."))
+		assert.Equal(t, []string{"value should not be blank."}, bag.GetMessage("name"))
+
+		warned := errpack.WithSeverity(bag, errpack.SeverityWarning).(*errpack.Bag)
+		withAttribute := bag.AddParam(errpack.NewParam("attribute", "name")).(*errpack.Bag)
+		customized := bag.SetMessages(map[string]map[string]string{"name": {code.IsNotBlank: "{{.attribute}} is required."}})
+		assert.False(t, warned.Fails())
+		assert.Equal(t, []string{"name should not be blank."}, withAttribute.GetMessage("name"))
+		assert.Equal(t, []string{"value is required."}, customized.GetMessage("name"))
+
+		assert.True(t, bag.Fails())
+		assert.False(t, bag.HasWarnings())
+		assert.False(t, bag.GetError("name", code.IsNotBlank).HasParam("attribute"))
+		assert.Equal(t, []string{"value should not be blank."}, bag.GetMessage("name"))
+	})
+
+	t.Run("translator", func(t *testing.T) {
+		translator.RegisterTranslation("test-immutable", map[string]string{
+			code.IsNotBlank: "{{.attribute}} est requis.",
+		})
+		e := errpack.NewError(code.IsNotBlank, "{{.attribute}} should not be blank.", errpack.NewParam("attribute", "name")).
+			SetTranslator(translator.New())
+		assert.Equal(t, "name should not be blank.", e.Error())
+		assert.Equal(t, "name est requis.", e.SetTranslator(translator.New().Locale("test-immutable")).Error())
+		assert.Equal(t, "name should not be blank.", e.Error())
+	})
+
+	t.Run("shared error builder", func(t *testing.T) {
+		v, err := NewValidator(
+			WithErrorBuilder(new(sharedErrorBuilder)),
+			WithMessages(map[string]string{code.IsMinLength: "{{.attribute}} is too short."}),
+			WithConcurrency(4),
+		)
+		if err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 32; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				name := "field" + strconv.Itoa(i)
+				validated := v.Validate(context.Background(),
+					NotBlank(name, ""),
+					MinLength(name+"_code", "a", 3),
+				)
+				assert.Equal(t, name+" should not be blank.", validated.GetError(name, code.IsNotBlank).Error())
+				assert.Equal(t, name+"_code is too short.", validated.GetError(name+"_code", code.IsMinLength).Error())
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func TestBag_Transform(t *testing.T) {
+	v, err := NewValidator()
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	header := v.Validate(context.Background(),
+		NotBlank("Authorization", ""),
+	).(*errpack.Bag)
+	body := v.Validate(context.Background(),
+		NotBlank("UserName", ""),
+		NotBlank("Address.ZipCode", ""),
+		NotBlank("Address.Street", ""),
+		Each("Items", []string{"", "b", ""}, validator.IsNotBlank[string]()),
+		Warn(MinLength("Password", "abc", 8)),
+	).(*errpack.Bag)
+
+	t.Run("merge and prefix", func(t *testing.T) {
+		merged := header.Prefix("header").Merge(body.Prefix("body"))
+		assert.Equal(t, []string{"header.Authorization", "body.UserName", "body.Address.ZipCode", "body.Address.Street", "body.Items.0", "body.Items.2"}, merged.Failed())
+		assert.True(t, merged.HasWarnings())
+		assert.Equal(t, []string{"Authorization"}, header.Failed(), "the bag is left untouched")
+		assert.Equal(t, "Authorization should not be blank.", merged.GetError("header.Authorization", code.IsNotBlank).Error())
+
+		other := errpack.NewBag()
+		other.AddError("UserName", errpack.NewError(code.IsUnique, "UserName is taken."))
+		merged = body.Merge(other)
+		assert.Equal(t, []string{"UserName should not be blank.", "UserName is taken."}, merged.GetMessage("UserName"))
+	})
+
+	t.Run("only and except", func(t *testing.T) {
+		assert.Equal(t, []string{"Address.ZipCode", "Address.Street"}, body.Only("Address").Failed())
+		assert.Equal(t, []string{"UserName", "Address.Street"}, body.Only("UserName", "Address.Street").Failed())
+		assert.Equal(t, []string{"Items.0", "Items.2"}, body.Only("Items.*").Failed())
+		assert.Equal(t, []string{"UserName"}, body.Except("Address", "Items", "Password").Failed())
+		assert.False(t, body.Except("Password").HasWarnings())
+		assert.Empty(t, body.Only("Unknown").Failed())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		filtered := body.Filter(func(key string, err validation.Error) bool {
+			return errpack.SeverityOf(err) == errpack.SeverityError && !strings.HasPrefix(key, "Items")
+		})
+		assert.Equal(t, []string{"UserName", "Address.ZipCode", "Address.Street"}, filtered.Failed())
+		assert.False(t, filtered.HasWarnings())
+	})
+
+	t.Run("rename keys", func(t *testing.T) {
+		renamed := body.RenameKeys(map[string]string{
+			"UserName":        "user_name",
+			"Address":         "address",
+			"Address.ZipCode": "address.zip_code",
+			"Items":           "items",
+		})
+		assert.Equal(t, []string{"user_name", "address.zip_code", "address.Street", "items.0", "items.2"}, renamed.Failed())
+		assert.Equal(t, "UserName should not be blank.", renamed.GetError("user_name", code.IsNotBlank).Error())
+
+		renamed = body.RenameKeysFunc(strings.ToLower)
+		assert.Equal(t, []string{"username", "address.zipcode", "address.street", "items.0", "items.2"}, renamed.Failed())
+
+		merged := body.RenameKeys(map[string]string{"Address.ZipCode": "address", "Address.Street": "address"})
+		assert.Len(t, merged.GetMessage("address"), 2)
+		assert.Equal(t, []errpack.Path{{"UserName"}, {"address"}, {"Items", "0"}, {"Items", "2"}, {"Password"}}, merged.Paths())
+	})
+}
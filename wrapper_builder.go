@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/gopi-frame/contract/validation"
+	error2 "github.com/gopi-frame/validation/errpack"
+	"github.com/gopi-frame/validation/validator"
+)
+
+// wrapperBuilder is a validator builder wrapping the validators of the given builders, see [When] and [Warn].
+// Keys set via SetKey are prefixed to the keys of the given builders,
+// and the attribute set via SetAttribute is added to the errors having none.
+type wrapperBuilder struct {
+	builders []validation.ValidatorBuilder
+	// wrap returns the function wrapping the validators of a single build.
+	wrap      func() func(v validation.Validatable) validation.Validatable
+	attribute string
+	paths     []string
+}
+
+func (b *wrapperBuilder) SetAttribute(attribute string) validation.ValidatorBuilder {
+	b.attribute = attribute
+	return b
+}
+
+func (b *wrapperBuilder) GetAttribute() string {
+	return b.attribute
+}
+
+func (b *wrapperBuilder) SetKey(paths ...string) validation.ValidatorBuilder {
+	b.paths = paths
+	return b
+}
+
+func (b *wrapperBuilder) GetKey() []string {
+	return b.paths
+}
+
+func (b *wrapperBuilder) Build(ctx validation.ValidatorContext) {
+	wrap := b.wrap()
+	inner := new(validateContext)
+	for _, builder := range b.builders {
+		builder.Build(inner)
+	}
+	for _, key := range inner.keys {
+		fullKey := key
+		if len(b.paths) > 0 {
+			fullKey = joinPaths(b.paths).Join(error2.ParsePath(key)).String()
+		}
+		for _, v := range inner.validators[key] {
+			ctx.AddValidate(fullKey, b.withAttribute(wrap(v)))
+		}
+	}
+}
+
+// withAttribute returns the validatable adding the attribute to its errors having none, keeping whether it bails.
+func (b *wrapperBuilder) withAttribute(v validation.Validatable) validation.Validatable {
+	if b.attribute == "" {
+		return v
+	}
+	var wrapped validation.Validatable = validator.ValidatableFunc(func(ctx context.Context, builder validation.ErrorBuilder) validation.Error {
+		err := v.Validate(ctx, builder)
+		if err != nil && !err.HasParam("attribute") {
+			err = err.AddParam(error2.NewParam("attribute", b.attribute))
+		}
+		return err
+	})
+	if _, bail := v.(bailValidatable); bail {
+		wrapped = bailValidatable{wrapped}
+	}
+	return wrapped
+}